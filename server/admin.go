@@ -0,0 +1,210 @@
+package main
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Seat states reported by the admin API.
+const (
+	SeatEmpty        = "empty"
+	SeatConnected    = "connected"
+	SeatDisconnected = "disconnected"
+	SeatBot          = "bot"
+)
+
+// SeatInfo describes one seat for the admin API.
+type SeatInfo struct {
+	Name  string `json:"name,omitempty"`
+	State string `json:"state"` // one of the Seat states
+}
+
+// RoomInfo summarizes a room for the admin API.
+type RoomInfo struct {
+	Code       string     `json:"code"`
+	Phase      Phase      `json:"phase"`
+	Seats      []SeatInfo `json:"seats"`
+	Spectators int        `json:"spectators"`
+	Created    time.Time  `json:"created"`
+	AgeSeconds int64      `json:"ageSeconds"`
+}
+
+// infoLocked summarizes the room. The caller must hold r.mu.
+func (r *Room) infoLocked() RoomInfo {
+	info := RoomInfo{
+		Code:       r.Code,
+		Phase:      PhaseLobby,
+		Seats:      make([]SeatInfo, len(r.Players)),
+		Spectators: len(r.Spectators),
+		Created:    r.Created,
+		AgeSeconds: int64(time.Since(r.Created).Seconds()),
+	}
+
+	if r.Game != nil {
+		info.Phase = r.Game.Phase
+	}
+
+	for i, name := range r.namesLocked() {
+		seat := SeatInfo{Name: name, State: SeatEmpty}
+		switch p := r.Players[i]; {
+		case p != nil && p.bot:
+			seat.State = SeatBot
+		case p != nil:
+			seat.State = SeatConnected
+		case r.Disconnected[i] != nil:
+			seat.State = SeatDisconnected
+		}
+
+		info.Seats[i] = seat
+	}
+
+	return info
+}
+
+// Rooms summarizes every room, for the admin API.
+func (rm *RoomManager) Rooms() []RoomInfo {
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+
+	infos := make([]RoomInfo, 0, len(rm.rooms))
+	for _, room := range rm.rooms {
+		room.mu.Lock()
+		infos = append(infos, room.infoLocked())
+		room.mu.Unlock()
+	}
+
+	return infos
+}
+
+// CloseRoom removes a room on an operator's request, telling everyone in it
+// why. Returns false if there is no such room.
+func (rm *RoomManager) CloseRoom(code, reason string) bool {
+	room := rm.GetRoom(code)
+	if room == nil {
+		return false
+	}
+
+	room.mu.Lock()
+	audience := room.audienceLocked()
+	room.mu.Unlock()
+
+	broadcast(audience, RoomClosedMsg{Type: "room_closed", Reason: reason})
+	rm.RemoveRoom(code)
+
+	slog.Info("room closed by admin", "code", code, "reason", reason)
+	return true
+}
+
+// adminHandler serves the admin API. Every request must carry token as a
+// bearer token.
+func adminHandler(rooms *RoomManager, token string) http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /admin/rooms", handleAdminRooms(rooms))
+	mux.HandleFunc("GET /admin/rooms/{code}", handleAdminRoom(rooms))
+	mux.HandleFunc("POST /admin/rooms/{code}/close", handleAdminCloseRoom(rooms))
+	mux.HandleFunc("POST /admin/notice", handleAdminNotice(rooms))
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || subtle.ConstantTimeCompare([]byte(got), []byte(token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		mux.ServeHTTP(w, r)
+	})
+}
+
+// writeJSON writes v as the JSON response.
+func writeJSON(w http.ResponseWriter, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		slog.Warn("failed to write admin response", "error", err)
+	}
+}
+
+func handleAdminRooms(rooms *RoomManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, rooms.Rooms())
+	}
+}
+
+func handleAdminRoom(rooms *RoomManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		room := rooms.GetRoom(r.PathValue("code"))
+		if room == nil {
+			http.Error(w, "room not found", http.StatusNotFound)
+			return
+		}
+
+		// Marshal while locked; the game keeps changing once it is released
+		room.mu.Lock()
+		data, err := json.Marshal(struct {
+			RoomInfo
+			Rules          Rules  `json:"rules"`
+			PlayAgainReady []bool `json:"playAgainReady"`
+			Game           *Game  `json:"game"`
+		}{room.infoLocked(), room.Rules, room.PlayAgainReady, room.Game})
+		room.mu.Unlock()
+
+		if err != nil {
+			slog.Error("failed to marshal room", "room", room.Code, "error", err)
+			http.Error(w, "failed to marshal room", http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Write(data)
+	}
+}
+
+func handleAdminCloseRoom(rooms *RoomManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Reason string `json:"reason"`
+		}
+
+		if r.ContentLength != 0 {
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				http.Error(w, "invalid request body", http.StatusBadRequest)
+				return
+			}
+		}
+
+		if body.Reason == "" {
+			body.Reason = "closed by the server operator"
+		}
+
+		if !rooms.CloseRoom(r.PathValue("code"), body.Reason) {
+			http.Error(w, "room not found", http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func handleAdminNotice(rooms *RoomManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Message string `json:"message"`
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || strings.TrimSpace(body.Message) == "" {
+			http.Error(w, "message is required", http.StatusBadRequest)
+			return
+		}
+
+		sent := rooms.Notify(MaintenanceNoticeMsg{Type: "maintenance_notice", Message: body.Message})
+		slog.Info("maintenance notice sent", "clients", sent)
+
+		writeJSON(w, struct {
+			Clients int `json:"clients"`
+		}{sent})
+	}
+}
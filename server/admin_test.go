@@ -0,0 +1,144 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// adminRequest sends a request to the admin API with the given token.
+func adminRequest(t *testing.T, handler http.Handler, method, path, token, body string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestAdminRequiresToken(t *testing.T) {
+	handler := adminHandler(NewRoomManager(), "secret")
+
+	for _, token := range []string{"", "wrong"} {
+		if rec := adminRequest(t, handler, "GET", "/admin/rooms", token, ""); rec.Code != http.StatusUnauthorized {
+			t.Errorf("token %q: expected 401, got %d", token, rec.Code)
+		}
+	}
+
+	if rec := adminRequest(t, handler, "GET", "/admin/rooms", "secret", ""); rec.Code != http.StatusOK {
+		t.Errorf("expected 200 with the token, got %d", rec.Code)
+	}
+}
+
+func TestAdminRooms(t *testing.T) {
+	rm := NewRoomManager()
+	handler := adminHandler(rm, "secret")
+
+	room, err := rm.CreateRoom(DefaultRules())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	alice, bob := newTestClient(rm), newTestClient(rm)
+	sendTestMsg(t, alice, JoinRoomMsg{Type: "join_room", Name: "Alice", RoomCode: room.Code})
+	sendTestMsg(t, bob, JoinRoomMsg{Type: "join_room", Name: "Bob", RoomCode: room.Code})
+	room.DisconnectPlayer(bob, rm)
+
+	var infos []RoomInfo
+	rec := adminRequest(t, handler, "GET", "/admin/rooms", "secret", "")
+	if err := json.Unmarshal(rec.Body.Bytes(), &infos); err != nil {
+		t.Fatalf("invalid response: %v", err)
+	}
+
+	want := []SeatInfo{{"Alice", SeatConnected}, {"Bob", SeatDisconnected}}
+	if len(infos) != 1 || infos[0].Phase != PhaseTurnOrderPick || infos[0].Seats[0] != want[0] || infos[0].Seats[1] != want[1] {
+		t.Errorf("unexpected rooms: %+v", infos)
+	}
+
+	var dump struct {
+		Code string `json:"code"`
+		Game *Game  `json:"game"`
+	}
+	rec = adminRequest(t, handler, "GET", "/admin/rooms/"+room.Code, "secret", "")
+	if err := json.Unmarshal(rec.Body.Bytes(), &dump); err != nil {
+		t.Fatalf("invalid response: %v", err)
+	}
+
+	if dump.Code != room.Code || dump.Game == nil || len(dump.Game.Hands) != 2 {
+		t.Errorf("expected the full game in the dump, got %s", rec.Body.String())
+	}
+
+	if rec := adminRequest(t, handler, "GET", "/admin/rooms/NOPE", "secret", ""); rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for an unknown room, got %d", rec.Code)
+	}
+}
+
+func TestAdminCloseRoom(t *testing.T) {
+	rm := NewRoomManager()
+	handler := adminHandler(rm, "secret")
+
+	room, err := rm.CreateRoom(DefaultRules())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	alice := newTestClient(rm)
+	sendTestMsg(t, alice, JoinRoomMsg{Type: "join_room", Name: "Alice", RoomCode: room.Code})
+	drainTypes(t, alice)
+
+	rec := adminRequest(t, handler, "POST", "/admin/rooms/"+room.Code+"/close", "secret", `{"reason":"maintenance"}`)
+	if rec.Code != http.StatusNoContent {
+		t.Fatalf("expected 204, got %d", rec.Code)
+	}
+
+	var closed RoomClosedMsg
+	if err := json.Unmarshal(<-alice.send, &closed); err != nil {
+		t.Fatalf("invalid message: %v", err)
+	}
+
+	if closed.Type != "room_closed" || closed.Reason != "maintenance" {
+		t.Errorf("unexpected message: %+v", closed)
+	}
+
+	if rm.GetRoom(room.Code) != nil {
+		t.Error("expected the room to be removed")
+	}
+
+	sendTestMsg(t, alice, Envelope{Type: "echo"})
+	if alice.room != nil {
+		t.Error("expected the client to leave the closed room")
+	}
+}
+
+func TestAdminNotice(t *testing.T) {
+	rm := NewRoomManager()
+	handler := adminHandler(rm, "secret")
+
+	alice := newTestClient(rm)
+	rm.Track(alice)
+
+	rec := adminRequest(t, handler, "POST", "/admin/notice", "secret", `{"message":"restarting soon"}`)
+	if rec.Code != http.StatusOK || !strings.Contains(rec.Body.String(), `"clients":1`) {
+		t.Fatalf("unexpected response %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var notice map[string]any
+	if err := json.Unmarshal(<-alice.send, &notice); err != nil {
+		t.Fatalf("invalid message: %v", err)
+	}
+
+	if notice["type"] != "maintenance_notice" || notice["message"] != "restarting soon" || notice["seq"] != nil {
+		t.Errorf("unexpected notice: %v", notice)
+	}
+
+	if rec := adminRequest(t, handler, "POST", "/admin/notice", "secret", `{}`); rec.Code != http.StatusBadRequest {
+		t.Errorf("expected 400 without a message, got %d", rec.Code)
+	}
+}
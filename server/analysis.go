@@ -0,0 +1,126 @@
+package main
+
+import "sort"
+
+// InvertedPair is two neighbouring revealed cards that are out of order.
+// Empty slots are skipped, so the slots need not be adjacent on the board.
+type InvertedPair struct {
+	SlotA  int  `json:"slotA"`
+	SlotB  int  `json:"slotB"`
+	CardA  Card `json:"cardA"`
+	CardB  Card `json:"cardB"`
+	OwnerA int  `json:"ownerA"`
+	OwnerB int  `json:"ownerB"`
+}
+
+// Offender is a card outside the longest correctly ordered subsequence,
+// together with the player who placed it.
+type Offender struct {
+	SlotIndex int  `json:"slotIndex"`
+	Card      Card `json:"card"`
+	Owner     int  `json:"owner"`
+}
+
+// GameAnalysis explains where the board ordering broke.
+type GameAnalysis struct {
+	InvertedPairs  []InvertedPair `json:"invertedPairs"`
+	LongestOrdered int            `json:"longestOrdered"` // cards in the longest correctly ordered subsequence
+	MinSwaps       int            `json:"minSwaps"`       // fewest two-card swaps that would sort the board
+	Offenders      []Offender     `json:"offenders"`
+}
+
+// Analyze computes a post-game analysis of the board in reveal order.
+func (g *Game) Analyze() GameAnalysis {
+	order := g.RevealOrder()
+	analysis := GameAnalysis{
+		InvertedPairs: []InvertedPair{},
+		Offenders:     []Offender{},
+	}
+
+	for i := 1; i < len(order); i++ {
+		a, b := order[i-1], order[i]
+		if a.Card.SortIndex(g.Deck) > b.Card.SortIndex(g.Deck) {
+			analysis.InvertedPairs = append(analysis.InvertedPairs, InvertedPair{
+				SlotA:  a.SlotIndex,
+				SlotB:  b.SlotIndex,
+				CardA:  a.Card,
+				CardB:  b.Card,
+				OwnerA: g.BoardOwner[a.SlotIndex],
+				OwnerB: g.BoardOwner[b.SlotIndex],
+			})
+		}
+	}
+
+	inOrder := longestOrdered(g.Deck, order)
+	for i, entry := range order {
+		if inOrder[i] {
+			analysis.LongestOrdered++
+			continue
+		}
+
+		analysis.Offenders = append(analysis.Offenders, Offender{
+			SlotIndex: entry.SlotIndex,
+			Card:      entry.Card,
+			Owner:     g.BoardOwner[entry.SlotIndex],
+		})
+	}
+
+	analysis.MinSwaps = minSwaps(g.Deck, order)
+	return analysis
+}
+
+// longestOrdered marks the entries that belong to one longest strictly
+// increasing subsequence of sort indexes.
+func longestOrdered(deck DeckDef, order []RevealEntry) []bool {
+	n := len(order)
+	length := make([]int, n)
+	prev := make([]int, n)
+	best := -1
+
+	for i := range order {
+		length[i], prev[i] = 1, -1
+		for j := 0; j < i; j++ {
+			if order[j].Card.SortIndex(deck) < order[i].Card.SortIndex(deck) && length[j]+1 > length[i] {
+				length[i], prev[i] = length[j]+1, j
+			}
+		}
+
+		if best == -1 || length[i] > length[best] {
+			best = i
+		}
+	}
+
+	marked := make([]bool, n)
+	for i := best; i >= 0; i = prev[i] {
+		marked[i] = true
+	}
+
+	return marked
+}
+
+// minSwaps returns the fewest swaps of two cards needed to put order into
+// sorted order. Each permutation cycle of length k needs k-1 swaps.
+func minSwaps(deck DeckDef, order []RevealEntry) int {
+	n := len(order)
+	sorted := make([]int, n)
+	for i := range sorted {
+		sorted[i] = i
+	}
+
+	sort.Slice(sorted, func(a, b int) bool {
+		return order[sorted[a]].Card.SortIndex(deck) < order[sorted[b]].Card.SortIndex(deck)
+	})
+
+	visited := make([]bool, n)
+	swaps := 0
+	for i := range sorted {
+		for j, k := i, 0; !visited[j]; j, k = sorted[j], k+1 {
+			visited[j] = true
+			if k > 0 {
+				swaps++
+			}
+		}
+	}
+
+	return swaps
+}
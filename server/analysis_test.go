@@ -0,0 +1,112 @@
+package main
+
+import "testing"
+
+// boardGame builds a game whose board holds cards at the given slots,
+// owned alternately by players 1 and 2.
+func boardGame(cards map[int]Card) *Game {
+	g := newGame(DefaultRules(), [][]Card{nil, nil})
+	g.Phase = PhaseGameOver
+
+	owner := 1
+	for slot := range g.Board {
+		card, ok := cards[slot]
+		if !ok {
+			continue
+		}
+
+		g.Board[slot] = &card
+		g.BoardOwner[slot] = owner
+		owner = 3 - owner
+	}
+
+	return g
+}
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		name          string
+		cards         map[int]Card
+		wantInverted  int
+		wantLongest   int
+		wantMinSwaps  int
+		wantOffenders []int // offending slots
+	}{
+		{
+			name:          "sorted board",
+			cards:         map[int]Card{0: {Hearts, 1}, 2: {Spades, 4}, 5: {Clubs, 9}},
+			wantLongest:   3,
+			wantOffenders: []int{},
+		},
+		{
+			name:          "one adjacent swap",
+			cards:         map[int]Card{0: {Hearts, 1}, 1: {Spades, 4}, 2: {Hearts, 5}, 3: {Clubs, 9}},
+			wantInverted:  1,
+			wantLongest:   3,
+			wantMinSwaps:  1,
+			wantOffenders: []int{2},
+		},
+		{
+			name:          "fully reversed",
+			cards:         map[int]Card{0: {Clubs, 1}, 1: {Diamonds, 1}, 2: {Spades, 1}, 3: {Hearts, 1}},
+			wantInverted:  3,
+			wantLongest:   1,
+			wantMinSwaps:  2,
+			wantOffenders: []int{1, 2, 3},
+		},
+		{
+			name:          "three-cycle",
+			cards:         map[int]Card{0: {Spades, 1}, 4: {Diamonds, 1}, 9: {Hearts, 1}},
+			wantInverted:  1,
+			wantLongest:   2,
+			wantMinSwaps:  2,
+			wantOffenders: []int{9},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := boardGame(tt.cards).Analyze()
+
+			if len(a.InvertedPairs) != tt.wantInverted {
+				t.Errorf("inverted pairs = %d, want %d", len(a.InvertedPairs), tt.wantInverted)
+			}
+
+			if a.LongestOrdered != tt.wantLongest {
+				t.Errorf("longest ordered = %d, want %d", a.LongestOrdered, tt.wantLongest)
+			}
+
+			if a.MinSwaps != tt.wantMinSwaps {
+				t.Errorf("min swaps = %d, want %d", a.MinSwaps, tt.wantMinSwaps)
+			}
+
+			if len(a.Offenders) != len(tt.wantOffenders) {
+				t.Fatalf("offenders = %v, want slots %v", a.Offenders, tt.wantOffenders)
+			}
+
+			for i, o := range a.Offenders {
+				if o.SlotIndex != tt.wantOffenders[i] {
+					t.Errorf("offender %d at slot %d, want %d", i, o.SlotIndex, tt.wantOffenders[i])
+				}
+			}
+		})
+	}
+}
+
+func TestAnalyzeOwners(t *testing.T) {
+	g := boardGame(map[int]Card{0: {Spades, 1}, 1: {Hearts, 1}})
+	a := g.Analyze()
+
+	if len(a.InvertedPairs) != 1 {
+		t.Fatalf("expected 1 inverted pair, got %d", len(a.InvertedPairs))
+	}
+
+	pair := a.InvertedPairs[0]
+	if pair.OwnerA != 1 || pair.OwnerB != 2 {
+		t.Errorf("expected owners 1 and 2, got %d and %d", pair.OwnerA, pair.OwnerB)
+	}
+
+	if len(a.Offenders) != 1 || a.Offenders[0].Owner != 2 {
+		t.Errorf("expected player 2's H1 as the offender, got %v", a.Offenders)
+	}
+}
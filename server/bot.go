@@ -0,0 +1,197 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+)
+
+// botName is the display name given to server-side bot partners.
+const botName = "Bot"
+
+// botThinkTime is the pause before a bot acts, so the human partner sees a
+// natural pace instead of instant replies. It is read when a bot is created;
+// tests may shorten it.
+var botThinkTime = 700 * time.Millisecond
+
+// NewBotClient creates a Client without a WebSocket connection, played by
+// strategy. Messages sent to it are consumed by runBot instead of a write pump.
+func NewBotClient(rooms *RoomManager, strategy Strategy) *Client {
+	c := &Client{
+		rooms:    rooms,
+		send:     make(chan []byte, 64),
+		bot:      true,
+		strategy: strategy,
+		think:    botThinkTime,
+	}
+	c.out = newOutbox(c)
+
+	return c
+}
+
+// addBot seats a bot partner in the room. The bot joins through the regular
+// join_room path, so the humans receive the usual player_joined and
+// turn_order_prompt messages. Returns false if the bot could not be seated.
+func addBot(rooms *RoomManager, room *Room, strategy Strategy) bool {
+	name := room.freeBotName()
+
+	raw, err := json.Marshal(JoinRoomMsg{Type: "join_room", Name: name, RoomCode: room.Code})
+	if err != nil {
+		slog.Error("failed to marshal bot join", "error", err)
+		return false
+	}
+
+	bot := NewBotClient(rooms, strategy)
+	go bot.runBot()
+
+	bot.handleMessage(raw)
+	if bot.room == nil {
+		close(bot.send)
+		return false
+	}
+
+	slog.Info("bot joined room", "room", room.Code)
+	return true
+}
+
+// freeBotName returns the first of "Bot", "Bot 2", "Bot 3", ... that no
+// player in the room is using.
+func (r *Room) freeBotName() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	taken := make(map[string]bool)
+	for _, p := range r.Players {
+		if p != nil {
+			taken[p.name] = true
+		}
+	}
+
+	name := botName
+	for n := 2; taken[name]; n++ {
+		name = fmt.Sprintf("%s %d", botName, n)
+	}
+
+	return name
+}
+
+// runBot consumes the bot's outgoing messages and reacts to them. Decisions are
+// made from the current room state rather than from individual messages, so a
+// burst of messages results in a single action.
+func (c *Client) runBot() {
+	for range c.send {
+		time.Sleep(c.think)
+
+		if !c.drainBot() {
+			return
+		}
+
+		action := c.botAction()
+		if action == nil {
+			continue
+		}
+
+		raw, err := json.Marshal(action)
+		if err != nil {
+			slog.Error("failed to marshal bot action", "error", err)
+			continue
+		}
+
+		c.handleMessage(raw)
+	}
+}
+
+// drainBot discards messages queued while the bot was thinking.
+// Returns false if the send channel has been closed.
+func (c *Client) drainBot() bool {
+	for {
+		select {
+		case _, ok := <-c.send:
+			if !ok {
+				return false
+			}
+		default:
+			return true
+		}
+	}
+}
+
+// botAction returns the client message the bot should send next, or nil if
+// there is nothing for it to do. The bot's strategy only sees the seat's
+// PlayerView, the same information a human in that seat has.
+func (c *Client) botAction() any {
+	room := c.room
+	if room == nil {
+		return nil
+	}
+
+	room.mu.Lock()
+	defer room.mu.Unlock()
+
+	g := room.Game
+	if g == nil {
+		return nil
+	}
+
+	idx := c.playerNumber - 1
+	view := g.ViewFor(c.playerNumber)
+
+	// Bots always let a partner take back a misplaced card
+	if _, requester, ok := g.PendingUndo(); ok && requester != c.playerNumber {
+		return RespondUndoMsg{Type: "respond_undo", Accept: true}
+	}
+
+	if g.SwapPending && g.SwapSuggester != c.playerNumber &&
+		(g.Phase == PhasePlacement || g.Phase == PhaseSwap) {
+		return RespondSwapMsg{Type: "respond_swap", Accept: c.strategy.RespondSwap(view)}
+	}
+
+	switch g.Phase {
+	case PhaseTurnOrderPick:
+		if g.Picks[idx] == "" {
+			return TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(c.strategy.PickTurnOrder(view))}
+		}
+
+	case PhasePlacement, PhaseSwap:
+		if g.CurrentTurn == c.playerNumber && !g.SwapPending {
+			return actionMessage(c.strategy.Act(view))
+		}
+
+	case PhaseGameOver:
+		if !room.PlayAgainReady[idx] {
+			return PlayAgainMsg{Type: "play_again"}
+		}
+	}
+
+	return nil
+}
+
+// actionMessage converts a strategy Action into the matching client message.
+func actionMessage(a Action) any {
+	switch a.Kind {
+	case ActionPlace:
+		return PlaceCardMsg{Type: "place_card", CardIndex: a.CardIndex, SlotIndex: a.SlotIndex}
+	case ActionPass:
+		return PassMsg{Type: "pass"}
+	case ActionSuggestSwap:
+		return SuggestSwapMsg{Type: "suggest_swap", SlotA: a.SlotA, SlotB: a.SlotB}
+	case ActionSkipSwap:
+		return SkipSwapMsg{Type: "skip_swap"}
+	}
+
+	return nil
+}
+
+// stopBots removes bot seats from the room and stops their goroutines.
+func (r *Room) stopBots() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, p := range r.Players {
+		if p != nil && p.bot {
+			r.Players[i] = nil
+			close(p.send)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBotRoomIsEmpty(t *testing.T) {
+	room := newRoom("TEST", DefaultRules())
+	human := &Client{name: "Alice"}
+	bot := &Client{name: botName, bot: true, send: make(chan []byte, 1)}
+
+	room.AddPlayer(human, "Alice")
+	room.AddPlayer(bot, botName)
+
+	if room.IsEmpty() {
+		t.Error("room with a human should not be empty")
+	}
+
+	room.RemovePlayer(human)
+	if !room.IsEmpty() {
+		t.Error("room with only a bot should be empty")
+	}
+
+	room.stopBots()
+	if room.Players[1] != nil {
+		t.Error("expected bot seat to be cleared")
+	}
+}
+
+func TestBotsPlayFullGame(t *testing.T) {
+	prev := botThinkTime
+	botThinkTime = 0
+	t.Cleanup(func() { botThinkTime = prev })
+
+	fourPlayers := DefaultRules()
+	fourPlayers.Players, fourPlayers.BoardSize = 4, 30
+
+	for name, rules := range map[string]Rules{"two players": DefaultRules(), "four players": fourPlayers} {
+		t.Run(name, func(t *testing.T) {
+			playBotGame(t, rules)
+		})
+	}
+}
+
+// playBotGame fills a room with bots and waits for them to finish a game.
+func playBotGame(t *testing.T, rules Rules) {
+	rm := NewRoomManager()
+	room, err := rm.CreateRoom(rules)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	t.Cleanup(func() { rm.RemoveRoom(room.Code) })
+
+	for i := 0; i < rules.Players; i++ {
+		strategy := Strategy(SpreadStrategy{})
+		if i%2 == 1 {
+			strategy = SwapperStrategy{}
+		}
+
+		if !addBot(rm, room, strategy) {
+			t.Fatalf("failed to seat bot %d", i+1)
+		}
+	}
+
+	room.mu.Lock()
+	first := room.Game
+	names := room.playerJoinedLocked(1).Players
+	room.mu.Unlock()
+
+	if first == nil {
+		t.Fatal("expected game to start when every seat is taken")
+	}
+
+	if names[0] != botName || names[1] != botName+" 2" {
+		t.Errorf("expected numbered bot names, got %v", names)
+	}
+
+	// Bots request a rematch as soon as a game ends, so a new Game value
+	// means the first game ran all the way through.
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		room.mu.Lock()
+		current := room.Game
+		room.mu.Unlock()
+
+		if current != first {
+			break
+		}
+
+		if time.Now().After(deadline) {
+			t.Fatal("bots did not finish a game in time")
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+
+	if first.Phase != PhaseGameOver {
+		t.Errorf("expected first game to be over, got %s", first.Phase)
+	}
+
+	if !first.AllCardsPlaced() {
+		t.Errorf("expected all cards placed, got %v", first.CardsPlaced)
+	}
+}
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Chat limits.
+const (
+	maxChatLength  = 200 // characters per message
+	maxChatHistory = 50  // messages kept per room
+)
+
+// ChatEntry is one chat message in a room's history.
+type ChatEntry struct {
+	FromPlayer int       `json:"fromPlayer"`
+	Name       string    `json:"name"`
+	Text       string    `json:"text"`
+	At         time.Time `json:"at"`
+}
+
+// chatAllowedLocked reports whether the room is between games, the only time
+// players may talk. The caller must hold r.mu.
+func (r *Room) chatAllowedLocked() bool {
+	return r.Game == nil || r.Game.Phase == PhaseGameOver
+}
+
+// addChatLocked appends an entry to the room's chat history, dropping the
+// oldest entry past maxChatHistory. The caller must hold r.mu.
+func (r *Room) addChatLocked(entry ChatEntry) {
+	r.chat = append(r.chat, entry)
+	if len(r.chat) > maxChatHistory {
+		r.chat = r.chat[len(r.chat)-maxChatHistory:]
+	}
+}
+
+func (c *Client) handleSendChat(raw []byte) {
+	var msg SendChatMsg
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		c.SendMsg(newError("invalid send_chat message"))
+		return
+	}
+
+	if c.room == nil {
+		c.SendMsg(newError("no active room"))
+		return
+	}
+
+	text := strings.TrimSpace(msg.Text)
+	if text == "" {
+		c.SendMsg(newError("message is empty"))
+		return
+	}
+
+	if utf8.RuneCountInString(text) > maxChatLength {
+		c.SendMsg(newError("message too long"))
+		return
+	}
+
+	c.room.mu.Lock()
+	if !c.room.chatAllowedLocked() {
+		c.room.mu.Unlock()
+		c.SendMsg(newError("chat is only allowed between games"))
+		return
+	}
+
+	entry := ChatEntry{FromPlayer: c.playerNumber, Name: c.name, Text: text, At: time.Now()}
+	c.room.addChatLocked(entry)
+	audience := c.room.audienceLocked()
+	c.room.mu.Unlock()
+
+	slog.Info("chat sent", "player", c.name, "room", c.room.Code)
+
+	broadcast(audience, ChatReceivedMsg{Type: "chat_received", ChatEntry: entry})
+}
@@ -0,0 +1,93 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestChat(t *testing.T) {
+	rm := NewRoomManager()
+	room, err := rm.CreateRoom(DefaultRules())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	alice, bob := newTestClient(rm), newTestClient(rm)
+	sendTestMsg(t, alice, JoinRoomMsg{Type: "join_room", Name: "Alice", RoomCode: room.Code})
+
+	sendTestMsg(t, alice, SendChatMsg{Type: "send_chat", Text: "  hi  "})
+	if got := drainTypes(t, alice); len(got) == 0 || got[len(got)-1] != "chat_received" {
+		t.Errorf("expected chat in the lobby, got %v", got)
+	}
+
+	sendTestMsg(t, bob, JoinRoomMsg{Type: "join_room", Name: "Bob", RoomCode: room.Code})
+	drainTypes(t, alice)
+	drainTypes(t, bob)
+
+	sendTestMsg(t, bob, SendChatMsg{Type: "send_chat", Text: "low cards left"})
+	if got := drainTypes(t, bob); len(got) != 1 || got[0] != "error" {
+		t.Errorf("expected chat to be rejected during play, got %v", got)
+	}
+
+	if got := drainTypes(t, alice); len(got) != 0 {
+		t.Errorf("expected nothing to reach Alice during play, got %v", got)
+	}
+
+	room.mu.Lock()
+	room.Game.Phase = PhaseGameOver
+	room.mu.Unlock()
+
+	for _, text := range []string{"", "   ", strings.Repeat("x", maxChatLength+1)} {
+		sendTestMsg(t, bob, SendChatMsg{Type: "send_chat", Text: text})
+		if got := drainTypes(t, bob); len(got) != 1 || got[0] != "error" {
+			t.Errorf("expected %q to be rejected, got %v", text, got)
+		}
+	}
+
+	sendTestMsg(t, bob, SendChatMsg{Type: "send_chat", Text: "gg"})
+	if got := drainTypes(t, alice); len(got) != 1 || got[0] != "chat_received" {
+		t.Errorf("expected chat once the game is over, got %v", got)
+	}
+
+	room.mu.Lock()
+	state := room.gameStateLocked(1)
+	room.mu.Unlock()
+
+	if len(state.Chat) != 2 || state.Chat[0].Text != "hi" || state.Chat[1].Name != "Bob" {
+		t.Errorf("unexpected chat history: %+v", state.Chat)
+	}
+}
+
+func TestChatLimits(t *testing.T) {
+	rm := NewRoomManager()
+	room, err := rm.CreateRoom(DefaultRules())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	alice := newTestClient(rm)
+	sendTestMsg(t, alice, JoinRoomMsg{Type: "join_room", Name: "Alice", RoomCode: room.Code})
+	drainTypes(t, alice)
+
+	burst := messageBudgets["send_chat"].burst
+	for range burst {
+		sendTestMsg(t, alice, SendChatMsg{Type: "send_chat", Text: "hello"})
+	}
+
+	sendTestMsg(t, alice, SendChatMsg{Type: "send_chat", Text: "hello?"})
+	got := drainTypes(t, alice)
+	if len(got) != burst+1 || got[burst] != "error" {
+		t.Errorf("expected the message past the burst to be rejected, got %v", got)
+	}
+
+	room.mu.Lock()
+	for range maxChatHistory {
+		room.addChatLocked(ChatEntry{Text: "spam"})
+	}
+	history := len(room.chat)
+	room.mu.Unlock()
+
+	if history != maxChatHistory {
+		t.Errorf("expected the history capped at %d, got %d", maxChatHistory, history)
+	}
+}
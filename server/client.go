@@ -3,6 +3,7 @@ package main
 import (
 	"encoding/json"
 	"log/slog"
+	"sync/atomic"
 	"time"
 
 	"github.com/gorilla/websocket"
@@ -15,8 +16,16 @@ const (
 	maxMessageSize = 4096
 	maxNameLength  = 20
 	delayPerCard   = 800 // ms between reveals
+
+	// sendBufferSize is how many messages may wait for a slow client. A client
+	// that falls further behind is disconnected; see fallBehind.
+	sendBufferSize = 64
 )
 
+// droppedSends counts messages that could not be queued for a client because
+// its send buffer was full.
+var droppedSends atomic.Uint64
+
 // Client represents a connected WebSocket player.
 type Client struct {
 	conn         *websocket.Conn
@@ -25,25 +34,52 @@ type Client struct {
 	name         string
 	playerNumber int
 	send         chan []byte
+	out          *Outbox                 // numbers outgoing messages; the seat's outbox while seated
+	spectator    bool                    // watching c.room without a seat
+	bot          bool                    // server-side bot partner with no WebSocket connection
+	strategy     Strategy                // bot only: decides the bot's moves
+	think        time.Duration           // bot only: pause before acting
+	closing      atomic.Bool             // the server is closing the connection; see closeWith
+	closeFrame   chan []byte             // for WritePump to send after the queue; see closeAfterQueued
+	limits       map[string]*tokenBucket // per message type; see messageBudgets
+	strikes      *tokenBucket            // rate limit violations before disconnecting
+	lastEmote    time.Time               // when the client last sent an emote; see emoteCooldown
 }
 
 // NewClient creates a new Client for a WebSocket connection.
 func NewClient(conn *websocket.Conn, rooms *RoomManager) *Client {
-	return &Client{
-		conn:  conn,
-		rooms: rooms,
-		send:  make(chan []byte, 16),
+	c := &Client{
+		conn:       conn,
+		rooms:      rooms,
+		send:       make(chan []byte, sendBufferSize),
+		closeFrame: make(chan []byte, 1),
 	}
+	c.out = newOutbox(c)
+
+	return c
 }
 
-// SendMsg marshals a message and queues it for sending.
+// SendMsg sends a message through the client's outbox, which gives it the
+// next sequence number.
 func (c *Client) SendMsg(msg any) {
+	c.out.SendMsg(msg)
+}
+
+// notify sends a connection-level notice, such as a maintenance notice,
+// straight to the connection. Notices are not part of a seat's history, so
+// they carry no seq and are not resent on resume.
+func (c *Client) notify(msg any) {
 	data, err := json.Marshal(msg)
 	if err != nil {
-		slog.Error("failed to marshal message", "error", err)
+		slog.Error("failed to marshal notice", "error", err)
 		return
 	}
 
+	c.deliver(data)
+}
+
+// deliver queues an already numbered message for sending.
+func (c *Client) deliver(data []byte) {
 	// Recover from sending on a closed channel. This can happen if cleanup()
 	// closes the channel while another goroutine holds a stale client pointer.
 	defer func() {
@@ -55,8 +91,51 @@ func (c *Client) SendMsg(msg any) {
 	select {
 	case c.send <- data:
 	default:
-		slog.Warn("send buffer full, dropping message", "player", c.name)
+		droppedSends.Add(1)
+		c.fallBehind()
+	}
+}
+
+// fallBehind handles a client whose send buffer is full. Rather than let it
+// silently miss messages, the connection is closed: the client reconnects and
+// catches up from its seat's outbox, or gets a full game_state. Bots act on
+// the room state rather than on messages, so they just skip the message.
+func (c *Client) fallBehind() {
+	if c.closeWith(websocket.CloseTryAgainLater, "too far behind") {
+		slog.Warn("send buffer full, disconnecting client", "player", c.name)
+	}
+}
+
+// closeWith closes the client's connection with a close code and reason. It
+// returns false if there is no connection or it is already being closed.
+func (c *Client) closeWith(code int, reason string) bool {
+	if c.conn == nil || !c.closing.CompareAndSwap(false, true) {
+		return false
+	}
+
+	// Callers may hold the room lock, so don't wait on the socket here.
+	go func() {
+		closeMsg := websocket.FormatCloseMessage(code, reason)
+		if err := c.conn.WriteControl(websocket.CloseMessage, closeMsg, time.Now().Add(writeWait)); err != nil {
+			slog.Warn("close message error", "player", c.name, "error", err)
+		}
+
+		c.conn.Close()
+	}()
+
+	return true
+}
+
+// closeAfterQueued closes the connection with a close code and reason once
+// the messages already queued for the client are written, so that a last
+// notice reaches it. Returns false if the connection is already closing.
+func (c *Client) closeAfterQueued(code int, reason string) bool {
+	if c.conn == nil || !c.closing.CompareAndSwap(false, true) {
+		return false
 	}
+
+	c.closeFrame <- websocket.FormatCloseMessage(code, reason)
+	return true
 }
 
 // ReadPump reads messages from the WebSocket and dispatches them.
@@ -113,6 +192,14 @@ func (c *Client) WritePump() {
 				return
 			}
 
+		case closeMsg := <-c.closeFrame:
+			c.flushQueued()
+			if err := c.conn.WriteControl(websocket.CloseMessage, closeMsg, time.Now().Add(writeWait)); err != nil {
+				slog.Warn("close message error", "player", c.name, "error", err)
+			}
+
+			return
+
 		case <-ticker.C:
 			if err := c.conn.SetWriteDeadline(time.Now().Add(writeWait)); err != nil {
 				slog.Warn("ping deadline error", "player", c.name, "error", err)
@@ -127,13 +214,70 @@ func (c *Client) WritePump() {
 	}
 }
 
+// flushQueued writes the messages already queued for the client.
+func (c *Client) flushQueued() {
+	for {
+		select {
+		case message, ok := <-c.send:
+			if !ok {
+				return
+			}
+
+			c.conn.SetWriteDeadline(time.Now().Add(writeWait))
+			if err := c.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+				slog.Warn("write error", "player", c.name, "error", err)
+				return
+			}
+
+		default:
+			return
+		}
+	}
+}
+
 func (c *Client) handleMessage(raw []byte) {
 	var env Envelope
 	if err := json.Unmarshal(raw, &env); err != nil {
-		c.SendMsg(newError("invalid message format"))
+		countReceived(unknownMessageType)
+		if c.allowMessage(unknownMessageType) {
+			c.SendMsg(newError("invalid message format"))
+		}
+
+		return
+	}
+
+	countReceived(env.Type)
+
+	// The room may have been closed under the client
+	if c.room != nil && c.room.Closed() {
+		c.room, c.spectator = nil, false
+	}
+
+	if !c.allowMessage(env.Type) {
+		return
+	}
+
+	if c.spectator && !spectatorMessages[env.Type] {
+		c.SendMsg(newError("spectators can only watch"))
 		return
 	}
 
+	// Persist whatever the message changed, including a room it left, and
+	// restart the turn timer for whatever the game now waits on.
+	if before := c.room; env.Type != "echo" && env.Type != "request_state" {
+		defer func() {
+			if before != nil && before != c.room {
+				before.persist()
+				before.armTurnTimer()
+			}
+
+			if c.room != nil {
+				c.room.persist()
+				c.room.armTurnTimer()
+			}
+		}()
+	}
+
 	switch env.Type {
 	case "create_room":
 		c.handleCreateRoom(raw)
@@ -141,6 +285,8 @@ func (c *Client) handleMessage(raw []byte) {
 		c.handleJoinRoom(raw)
 	case "reconnect":
 		c.handleReconnect(raw)
+	case "request_state":
+		c.handleRequestState()
 	case "turn_order_pick":
 		c.handleTurnOrderPick(raw)
 	case "place_card":
@@ -155,6 +301,14 @@ func (c *Client) handleMessage(raw []byte) {
 		c.handleSkipSwap()
 	case "respond_swap":
 		c.handleRespondSwap(raw)
+	case "undo_request":
+		c.handleUndoRequest()
+	case "respond_undo":
+		c.handleRespondUndo(raw)
+	case "send_signal":
+		c.handleSendSignal(raw)
+	case "send_chat":
+		c.handleSendChat(raw)
 	case "play_again":
 		c.handlePlayAgain()
 	case "exit_game":
@@ -171,13 +325,21 @@ func (c *Client) handleMessage(raw []byte) {
 func (c *Client) cleanup() {
 	close(c.send)
 
+	if c.room != nil && c.room.Closed() {
+		return
+	}
+
+	if c.room != nil && c.spectator {
+		c.room.RemoveSpectator(c)
+		slog.Info("spectator disconnected", "spectator", c.name, "room", c.room.Code)
+		return
+	}
+
 	if c.room != nil {
-		if partner := c.room.Partner(c); partner != nil {
-			partner.SendMsg(PlayerDisconnectedMsg{
-				Type:       "player_disconnected",
-				PlayerName: c.name,
-			})
-		}
+		broadcast(c.room.Others(c), PlayerDisconnectedMsg{
+			Type:       "player_disconnected",
+			PlayerName: c.name,
+		})
 
 		// Mark as disconnected with grace period instead of removing immediately
 		c.room.DisconnectPlayer(c, c.rooms)
@@ -185,13 +347,11 @@ func (c *Client) cleanup() {
 	}
 }
 
-// broadcast sends a message to both players, skipping nil clients.
-func broadcast(p1, p2 *Client, msg any) {
-	if p1 != nil {
-		p1.SendMsg(msg)
-	}
-
-	if p2 != nil {
-		p2.SendMsg(msg)
+// broadcast sends a message to every outbox, skipping nil ones.
+func broadcast(players []*Outbox, msg any) {
+	for _, p := range players {
+		if p != nil {
+			p.SendMsg(msg)
+		}
 	}
 }
@@ -0,0 +1,28 @@
+package main
+
+import "testing"
+
+func TestFullSendBufferKeepsMessagesForResume(t *testing.T) {
+	slow := &Client{send: make(chan []byte, 2)}
+	slow.out = newOutbox(slow)
+
+	before := droppedSends.Load()
+	for i := 0; i < 5; i++ {
+		slow.SendMsg(YourTurnMsg{Type: "your_turn"})
+	}
+
+	if dropped := droppedSends.Load() - before; dropped != 3 {
+		t.Errorf("expected 3 dropped sends counted, got %d", dropped)
+	}
+
+	// The client saw seq 1 and 2 before falling behind; reconnecting resumes
+	// with everything after them.
+	returning := &Client{send: make(chan []byte, sendBufferSize)}
+	if !slow.out.Resume(returning, 2) {
+		t.Fatal("expected the outbox to still hold the dropped messages")
+	}
+
+	if got := seqs(t, returning); len(got) != 3 || got[0] != 3 {
+		t.Errorf("expected seq 3 to 5 resent, got %v", got)
+	}
+}
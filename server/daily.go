@@ -0,0 +1,124 @@
+package main
+
+import (
+	"encoding/json"
+	"hash/fnv"
+	"log/slog"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+const (
+	// dailyDateFormat names a daily challenge by its UTC date.
+	dailyDateFormat = "2006-01-02"
+
+	// maxDailyResults caps how many results are kept for one day.
+	maxDailyResults = 500
+
+	// dailyHistoryDays is how many days of results are kept.
+	dailyHistoryDays = 7
+)
+
+// DailyRules returns the rules every daily challenge room plays by, so that
+// the same seed deals the same hands everywhere.
+func DailyRules() Rules {
+	rules := DefaultRules()
+	rules.Daily = true
+	return rules
+}
+
+// DailyDate returns the daily challenge date for t.
+func DailyDate(t time.Time) string {
+	return t.UTC().Format(dailyDateFormat)
+}
+
+// DailySeed derives the deal seed for a daily challenge date.
+func DailySeed(date string) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte("daily:" + date))
+	return h.Sum64()
+}
+
+// DailyResult is one finished game of a daily challenge.
+type DailyResult struct {
+	Players  []string  `json:"players"`
+	Win      bool      `json:"win"`
+	Score    int       `json:"score"`
+	Finished time.Time `json:"finished"`
+}
+
+// DailyBoard collects daily challenge results so players can compare how they
+// did on the same deal.
+type DailyBoard struct {
+	mu   sync.Mutex
+	days map[string][]DailyResult
+}
+
+// NewDailyBoard creates an empty DailyBoard.
+func NewDailyBoard() *DailyBoard {
+	return &DailyBoard{days: make(map[string][]DailyResult)}
+}
+
+// Record adds a result for date and returns that day's results, best first.
+// Days older than dailyHistoryDays are dropped.
+func (b *DailyBoard) Record(date string, result DailyResult) []DailyResult {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.days[date]) < maxDailyResults {
+		b.days[date] = append(b.days[date], result)
+	}
+
+	cutoff := DailyDate(result.Finished.AddDate(0, 0, -dailyHistoryDays))
+	for day := range b.days {
+		if day < cutoff {
+			delete(b.days, day)
+		}
+	}
+
+	return b.resultsLocked(date)
+}
+
+// Results returns the results for date, best first.
+func (b *DailyBoard) Results(date string) []DailyResult {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	return b.resultsLocked(date)
+}
+
+func (b *DailyBoard) resultsLocked(date string) []DailyResult {
+	results := append([]DailyResult{}, b.days[date]...)
+	sort.SliceStable(results, func(i, j int) bool {
+		return results[i].Score > results[j].Score
+	})
+
+	return results
+}
+
+// handleDailyResults serves the results of a daily challenge as JSON.
+// The date query parameter (YYYY-MM-DD) defaults to today.
+func handleDailyResults(rooms *RoomManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		date := r.URL.Query().Get("date")
+		if date == "" {
+			date = DailyDate(time.Now())
+		}
+
+		if _, err := time.Parse(dailyDateFormat, date); err != nil {
+			http.Error(w, "invalid date", http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		err := json.NewEncoder(w).Encode(struct {
+			Date    string        `json:"date"`
+			Results []DailyResult `json:"results"`
+		}{date, rooms.daily.Results(date)})
+		if err != nil {
+			slog.Warn("failed to write daily results", "error", err)
+		}
+	}
+}
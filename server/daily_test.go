@@ -0,0 +1,94 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestDailySeed(t *testing.T) {
+	morning := time.Date(2026, 3, 14, 0, 30, 0, 0, time.UTC)
+	evening := time.Date(2026, 3, 14, 23, 30, 0, 0, time.UTC)
+	nextDay := time.Date(2026, 3, 15, 0, 30, 0, 0, time.UTC)
+
+	if DailyDate(morning) != "2026-03-14" {
+		t.Errorf("DailyDate = %q, want 2026-03-14", DailyDate(morning))
+	}
+
+	if DailySeed(DailyDate(morning)) != DailySeed(DailyDate(evening)) {
+		t.Error("expected the same seed all day")
+	}
+
+	if DailySeed(DailyDate(morning)) == DailySeed(DailyDate(nextDay)) {
+		t.Error("expected a new seed the next day")
+	}
+}
+
+func TestDailyRoomsDealTheSameHands(t *testing.T) {
+	rm := NewRoomManager()
+
+	var hands [][][]Card
+	for i := 0; i < 2; i++ {
+		room, err := rm.CreateRoom(DailyRules())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		game, err := room.newGame()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		hands = append(hands, game.Hands)
+	}
+
+	if !reflect.DeepEqual(hands[0], hands[1]) {
+		t.Error("expected daily rooms created the same day to deal the same hands")
+	}
+}
+
+func TestDailyRematchNotRecorded(t *testing.T) {
+	rm := NewRoomManager()
+	room, err := rm.CreateRoom(DailyRules())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	date := DailyDate(room.Created)
+	for i := range 2 {
+		game, err := room.newGame()
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if game.Daily != (i == 0) {
+			t.Errorf("game %d: Daily = %v, want only the first game dealt from the daily seed", i+1, game.Daily)
+		}
+
+		room.mu.Lock()
+		room.Game = game
+		finalizeOutcome(room)
+		room.mu.Unlock()
+	}
+
+	if got := rm.daily.Results(date); len(got) != 1 {
+		t.Errorf("expected only the first game on the board, got %d results", len(got))
+	}
+}
+
+func TestDailyBoard(t *testing.T) {
+	board := NewDailyBoard()
+	day := time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC)
+
+	board.Record("2026-03-01", DailyResult{Score: 50, Finished: day.AddDate(0, 0, -13)})
+	board.Record("2026-03-14", DailyResult{Players: []string{"A", "B"}, Score: 40, Finished: day})
+	results := board.Record("2026-03-14", DailyResult{Players: []string{"C", "D"}, Win: true, Score: 90, Finished: day})
+
+	if len(results) != 2 || results[0].Score != 90 || results[1].Score != 40 {
+		t.Errorf("expected both results best first, got %+v", results)
+	}
+
+	if old := board.Results("2026-03-01"); len(old) != 0 {
+		t.Errorf("expected results older than %d days to be dropped, got %+v", dailyHistoryDays, old)
+	}
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"fmt"
+	"unicode/utf8"
+)
+
+// Deck limits accepted from room creators.
+const (
+	maxSuits         = 6
+	maxValuesPerSuit = 20
+	maxCardValue     = 99
+	maxSuitLength    = 2
+)
+
+// DeckDef describes the cards a room plays with: every suit in Suits holds
+// one card of each value from MinValue to MaxValue. Suits are listed in
+// ascending sort order.
+type DeckDef struct {
+	Suits    []Suit `json:"suits"`
+	MinValue int    `json:"minValue"`
+	MaxValue int    `json:"maxValue"`
+}
+
+// DefaultDeck returns the standard 40-card deck: H < S < D < C, values 1–10.
+func DefaultDeck() DeckDef {
+	return DeckDef{
+		Suits:    []Suit{Hearts, Spades, Diamonds, Clubs},
+		MinValue: 1,
+		MaxValue: 10,
+	}
+}
+
+// ValuesPerSuit returns how many cards each suit holds.
+func (d DeckDef) ValuesPerSuit() int {
+	return d.MaxValue - d.MinValue + 1
+}
+
+// Size returns the number of cards in the deck.
+func (d DeckDef) Size() int {
+	return len(d.Suits) * d.ValuesPerSuit()
+}
+
+// Cards returns every card in the deck in sort order.
+func (d DeckDef) Cards() []Card {
+	deck := make([]Card, 0, d.Size())
+	for _, s := range d.Suits {
+		for i := range d.ValuesPerSuit() {
+			deck = append(deck, Card{Suit: s, Value: d.MinValue + i})
+		}
+	}
+
+	return deck
+}
+
+// SuitHint tells a player how two suits rank in a secret suit order.
+type SuitHint struct {
+	Lower  Suit `json:"lower"`
+	Higher Suit `json:"higher"`
+}
+
+// withHint returns a copy of d reordered so that it agrees with hint. It is a
+// player's best guess at a secret order: the public order with the hinted
+// pair swapped if needed.
+func (d DeckDef) withHint(hint *SuitHint) DeckDef {
+	guess := d
+	guess.Suits = append([]Suit(nil), d.Suits...)
+	if hint == nil {
+		return guess
+	}
+
+	lo, hi := guess.suitRank(hint.Lower), guess.suitRank(hint.Higher)
+	if lo > hi && hi >= 0 {
+		guess.Suits[lo], guess.Suits[hi] = guess.Suits[hi], guess.Suits[lo]
+	}
+
+	return guess
+}
+
+// suitRank returns the position of s in the suit order, or -1 if the deck
+// has no such suit.
+func (d DeckDef) suitRank(s Suit) int {
+	for i, suit := range d.Suits {
+		if suit == s {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Validate reports whether the deck definition describes a usable deck.
+func (d DeckDef) Validate() error {
+	switch {
+	case len(d.Suits) < 1 || len(d.Suits) > maxSuits:
+		return fmt.Errorf("deck must have between 1 and %d suits", maxSuits)
+	case d.MinValue < 1:
+		return fmt.Errorf("deck values must start at 1 or higher")
+	case d.MaxValue < d.MinValue:
+		return fmt.Errorf("deck max value must not be below its min value")
+	case d.MaxValue > maxCardValue:
+		return fmt.Errorf("deck values must not exceed %d", maxCardValue)
+	case d.ValuesPerSuit() > maxValuesPerSuit:
+		return fmt.Errorf("deck must have at most %d values per suit", maxValuesPerSuit)
+	}
+
+	seen := make(map[Suit]bool, len(d.Suits))
+	for _, s := range d.Suits {
+		if s == "" || utf8.RuneCountInString(string(s)) > maxSuitLength {
+			return fmt.Errorf("suit %q must be 1 to %d characters", s, maxSuitLength)
+		}
+
+		if seen[s] {
+			return fmt.Errorf("suit %q is listed twice", s)
+		}
+
+		seen[s] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package main
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestDeckDefValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(d *DeckDef)
+		valid  bool
+	}{
+		{"default", func(d *DeckDef) {}, true},
+		{"five suits of twelve", func(d *DeckDef) {
+			d.Suits = append(d.Suits, "R")
+			d.MaxValue = 12
+		}, true},
+		{"kids deck", func(d *DeckDef) { d.Suits = d.Suits[:2] }, true},
+		{"no suits", func(d *DeckDef) { d.Suits = nil }, false},
+		{"too many suits", func(d *DeckDef) { d.Suits = []Suit{"A", "B", "C", "D", "E", "F", "G"} }, false},
+		{"duplicate suit", func(d *DeckDef) { d.Suits = []Suit{Hearts, Hearts} }, false},
+		{"empty suit", func(d *DeckDef) { d.Suits = []Suit{Hearts, ""} }, false},
+		{"long suit", func(d *DeckDef) { d.Suits = []Suit{"Hearts"} }, false},
+		{"zero min value", func(d *DeckDef) { d.MinValue = 0 }, false},
+		{"max below min", func(d *DeckDef) { d.MinValue, d.MaxValue = 5, 4 }, false},
+		{"too many values", func(d *DeckDef) { d.MaxValue = maxValuesPerSuit + 1 }, false},
+		{"high values", func(d *DeckDef) { d.MinValue, d.MaxValue = 90, maxCardValue }, true},
+		{"value too high", func(d *DeckDef) { d.MinValue, d.MaxValue = math.MaxInt-5, math.MaxInt }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := DefaultDeck()
+			tt.modify(&d)
+
+			err := d.Validate()
+			if tt.valid && err != nil {
+				t.Errorf("expected valid deck, got %v", err)
+			}
+			if !tt.valid && err == nil {
+				t.Error("expected invalid deck")
+			}
+		})
+	}
+}
+
+func TestCustomDeckSortIndex(t *testing.T) {
+	deck := DeckDef{Suits: []Suit{Clubs, Hearts, "R"}, MinValue: 2, MaxValue: 13}
+
+	if deck.Size() != 36 {
+		t.Errorf("expected 36 cards, got %d", deck.Size())
+	}
+
+	tests := []struct {
+		card  Card
+		index int
+	}{
+		{Card{Clubs, 2}, 0},
+		{Card{Clubs, 13}, 11},
+		{Card{Hearts, 2}, 12},
+		{Card{"R", 13}, 35},
+	}
+	for _, tt := range tests {
+		if got := tt.card.SortIndex(deck); got != tt.index {
+			t.Errorf("%v.SortIndex() = %d, want %d", tt.card, got, tt.index)
+		}
+	}
+
+	cards := deck.Cards()
+	for i, c := range cards {
+		if c.SortIndex(deck) != i {
+			t.Fatalf("Cards() not in sort order at %d: %v", i, c)
+		}
+	}
+}
+
+func TestCheckWinUsesGameDeck(t *testing.T) {
+	rules := DefaultRules()
+	rules.Deck = DeckDef{Suits: []Suit{Clubs, Hearts}, MinValue: 1, MaxValue: 5}
+	g := newGame(rules, [][]Card{nil, nil})
+
+	// Clubs rank below Hearts in this deck
+	g.Board[0] = &Card{Clubs, 5}
+	g.Board[1] = &Card{Hearts, 1}
+	if !g.CheckWin() {
+		t.Error("expected C5 before H1 to be ordered in a Clubs-first deck")
+	}
+
+	g.Board[0], g.Board[1] = g.Board[1], g.Board[0]
+	if g.CheckWin() {
+		t.Error("expected H1 before C5 to be out of order in a Clubs-first deck")
+	}
+}
+
+func TestDeckWithHint(t *testing.T) {
+	tests := []struct {
+		name string
+		hint *SuitHint
+		want []Suit
+	}{
+		{"no hint", nil, []Suit{Hearts, Spades, Diamonds, Clubs}},
+		{"hint agrees", &SuitHint{Lower: Spades, Higher: Clubs}, []Suit{Hearts, Spades, Diamonds, Clubs}},
+		{"hint disagrees", &SuitHint{Lower: Clubs, Higher: Spades}, []Suit{Hearts, Clubs, Diamonds, Spades}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			deck := DefaultDeck()
+			got := deck.withHint(tt.hint)
+
+			if !reflect.DeepEqual(got.Suits, tt.want) {
+				t.Errorf("suits = %v, want %v", got.Suits, tt.want)
+			}
+
+			if !reflect.DeepEqual(deck, DefaultDeck()) {
+				t.Error("withHint modified the original deck")
+			}
+		})
+	}
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Emote limits.
+const (
+	defaultEmotePack = "classic"
+	maxEmotesPerPack = 12
+	maxEmoteLength   = 20
+	emoteCooldown    = 2 * time.Second // between emotes from one client
+)
+
+// EmotePacks maps emote pack names to the emotes in each pack. A room picks
+// one pack with Rules.Emotes and only its emotes may be sent there.
+type EmotePacks map[string][]string
+
+// DefaultEmotePacks returns the built-in emote packs.
+func DefaultEmotePacks() EmotePacks {
+	return EmotePacks{
+		defaultEmotePack: {"Wow", "Well Played", "Interesting"},
+	}
+}
+
+// LoadEmotePacks reads emote packs from a JSON file mapping pack names to
+// lists of emotes, such as {"cheerful": ["Nice!", "Oops"]}. The packs are
+// added to the built-in ones, replacing any with the same name.
+func LoadEmotePacks(path string) (EmotePacks, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("reading emote packs: %w", err)
+	}
+
+	var loaded EmotePacks
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		return nil, fmt.Errorf("parsing emote packs: %w", err)
+	}
+
+	packs := DefaultEmotePacks()
+	for name, emotes := range loaded {
+		if err := validateEmotePack(name, emotes); err != nil {
+			return nil, err
+		}
+
+		packs[name] = emotes
+	}
+
+	return packs, nil
+}
+
+// validateEmotePack reports whether a pack from the config file is usable.
+func validateEmotePack(name string, emotes []string) error {
+	if strings.TrimSpace(name) == "" {
+		return fmt.Errorf("emote pack name is required")
+	}
+
+	if len(emotes) == 0 || len(emotes) > maxEmotesPerPack {
+		return fmt.Errorf("emote pack %q must have between 1 and %d emotes", name, maxEmotesPerPack)
+	}
+
+	for _, emote := range emotes {
+		if strings.TrimSpace(emote) == "" || utf8.RuneCountInString(emote) > maxEmoteLength {
+			return fmt.Errorf("emote pack %q: emotes must be 1 to %d characters", name, maxEmoteLength)
+		}
+	}
+
+	return nil
+}
+
+// Pack returns the emotes in the named pack, with "" naming the default pack.
+func (p EmotePacks) Pack(name string) ([]string, bool) {
+	if name == "" {
+		name = defaultEmotePack
+	}
+
+	emotes, ok := p[name]
+	return emotes, ok
+}
+
+// allowsEmote reports whether emote is in the room's emote pack.
+func (r *Room) allowsEmote(emote string) bool {
+	return slices.Contains(r.emotes, emote)
+}
+
+// CountEmote records that a player sent an emote during the game.
+func (g *Game) CountEmote(playerNumber int) {
+	// Games saved before emotes were counted have no counts yet
+	if g.EmotesSent == nil {
+		g.EmotesSent = make([]int, len(g.Hands))
+	}
+
+	g.EmotesSent[playerNumber-1]++
+}
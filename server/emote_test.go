@@ -1,21 +1,128 @@
 package main
 
 import (
+	"os"
+	"path/filepath"
+	"reflect"
 	"testing"
+	"time"
 )
 
-func TestAllowedEmotes(t *testing.T) {
+func TestDefaultEmotePack(t *testing.T) {
+	room := newRoom("TEST", DefaultRules())
+	room.emotes, _ = DefaultEmotePacks().Pack("")
+
 	valid := []string{"Wow", "Well Played", "Interesting"}
 	for _, emote := range valid {
-		if !allowedEmotes[emote] {
+		if !room.allowsEmote(emote) {
 			t.Errorf("expected %q to be allowed", emote)
 		}
 	}
 
 	invalid := []string{"", "wow", "GG", "Hello", "well played", "INTERESTING"}
 	for _, emote := range invalid {
-		if allowedEmotes[emote] {
+		if room.allowsEmote(emote) {
 			t.Errorf("expected %q to be rejected", emote)
 		}
 	}
 }
+
+func TestLoadEmotePacks(t *testing.T) {
+	tests := []struct {
+		name    string
+		config  string
+		wantErr bool
+	}{
+		{"valid", `{"cheerful": ["Nice!", "Oops"]}`, false},
+		{"replaces built-in", `{"classic": ["GG"]}`, false},
+		{"not json", `cheerful`, true},
+		{"empty pack", `{"cheerful": []}`, true},
+		{"blank emote", `{"cheerful": [" "]}`, true},
+		{"long emote", `{"cheerful": ["This emote is far too long"]}`, true},
+		{"blank name", `{"": ["Nice!"]}`, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "emotes.json")
+			if err := os.WriteFile(path, []byte(tt.config), 0o644); err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			packs, err := LoadEmotePacks(path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LoadEmotePacks() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if err == nil {
+				if _, ok := packs.Pack(""); !ok {
+					t.Error("expected the default pack to remain")
+				}
+			}
+		})
+	}
+
+	if _, err := LoadEmotePacks(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("expected error for a missing file")
+	}
+}
+
+func TestRoomEmotePack(t *testing.T) {
+	rm := NewRoomManager()
+	rm.UseEmotePacks(EmotePacks{defaultEmotePack: {"Wow"}, "cheerful": {"Nice!", "Oops"}})
+
+	alice := newTestClient(rm)
+	rules := DefaultRules()
+	rules.Emotes = "grumpy"
+	sendTestMsg(t, alice, CreateRoomMsg{Type: "create_room", Name: "Alice", Rules: rules})
+	if got := drainTypes(t, alice); len(got) != 1 || got[0] != "error" {
+		t.Fatalf("expected an unknown pack to be rejected, got %v", got)
+	}
+
+	rules.Emotes = "cheerful"
+	sendTestMsg(t, alice, CreateRoomMsg{Type: "create_room", Name: "Alice", Rules: rules})
+	room := alice.room
+	if room == nil {
+		t.Fatal("expected a room to be created")
+	}
+
+	bob := newTestClient(rm)
+	sendTestMsg(t, bob, JoinRoomMsg{Type: "join_room", Name: "Bob", RoomCode: room.Code})
+
+	room.mu.Lock()
+	joined := room.playerJoinedLocked(2)
+	room.mu.Unlock()
+
+	if !reflect.DeepEqual(joined.Emotes, []string{"Nice!", "Oops"}) {
+		t.Errorf("expected the room's pack in player_joined, got %v", joined.Emotes)
+	}
+
+	drainTypes(t, bob)
+	drainTypes(t, alice)
+
+	sendTestMsg(t, alice, SendEmoteMsg{Type: "send_emote", Emote: "Wow"})
+	if got := drainTypes(t, alice); len(got) != 1 || got[0] != "error" {
+		t.Errorf("expected an emote from another pack to be rejected, got %v", got)
+	}
+
+	sendTestMsg(t, alice, SendEmoteMsg{Type: "send_emote", Emote: "Nice!"})
+	sendTestMsg(t, alice, SendEmoteMsg{Type: "send_emote", Emote: "Oops"})
+	if got := drainTypes(t, alice); len(got) != 1 || got[0] != "error" {
+		t.Errorf("expected the second emote to hit the cooldown, got %v", got)
+	}
+
+	if got := drainTypes(t, bob); len(got) != 1 || got[0] != "emote_received" {
+		t.Errorf("expected Bob to receive one emote, got %v", got)
+	}
+
+	alice.lastEmote = time.Now().Add(-emoteCooldown)
+	sendTestMsg(t, alice, SendEmoteMsg{Type: "send_emote", Emote: "Oops"})
+
+	room.mu.Lock()
+	sent := outcomeOf(room.Game).resultMsg().Emotes
+	room.mu.Unlock()
+
+	if !reflect.DeepEqual(sent, []int{2, 0}) {
+		t.Errorf("expected Alice's emotes counted in the result, got %v", sent)
+	}
+}
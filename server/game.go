@@ -1,10 +1,12 @@
 package main
 
 import (
-	"crypto/rand"
+	crand "crypto/rand"
+	"encoding/binary"
 	"fmt"
-	"math/big"
+	"math/rand/v2"
 	"sort"
+	"time"
 )
 
 // Suit represents a card suit.
@@ -17,68 +19,62 @@ const (
 	Clubs    Suit = "C"
 )
 
-// suitOrder defines the global sort order for suits.
-var suitOrder = map[Suit]int{
-	Hearts:   0,
-	Spades:   1,
-	Diamonds: 2,
-	Clubs:    3,
-}
-
 // Card represents a single playing card.
 type Card struct {
 	Suit  Suit `json:"suit"`
 	Value int  `json:"value"`
 }
 
-// SortIndex returns the global sort position of a card (0–39).
-func (c Card) SortIndex() int {
-	return suitOrder[c.Suit]*10 + (c.Value - 1)
+// SortIndex returns the sort position of a card within deck
+// (0 to deck.Size()-1).
+func (c Card) SortIndex(deck DeckDef) int {
+	return deck.suitRank(c.Suit)*deck.ValuesPerSuit() + (c.Value - deck.MinValue)
 }
 
 // NewDeck creates a standard 40-card deck (4 suits × 10 values).
 func NewDeck() []Card {
-	suits := []Suit{Hearts, Spades, Diamonds, Clubs}
-	deck := make([]Card, 0, 40)
-	for _, s := range suits {
-		for v := 1; v <= 10; v++ {
-			deck = append(deck, Card{Suit: s, Value: v})
-		}
-	}
-	return deck
+	return DefaultDeck().Cards()
 }
 
-// ShuffleDeck shuffles a deck in place using crypto/rand.
-func ShuffleDeck(deck []Card) error {
-	for i := len(deck) - 1; i > 0; i-- {
-		n, err := rand.Int(rand.Reader, big.NewInt(int64(i+1)))
-		if err != nil {
-			return fmt.Errorf("shuffling deck: %w", err)
-		}
+// NewRandomSource returns a randomness source seeded from crypto/rand, so
+// its games cannot be predicted.
+func NewRandomSource() rand.Source {
+	var seed [32]byte
+	crand.Read(seed[:]) // never returns an error
+	return rand.NewChaCha8(seed)
+}
 
-		j := int(n.Int64())
-		deck[i], deck[j] = deck[j], deck[i]
-	}
+// NewSeededSource returns a deterministic randomness source: games created
+// from the same seed deal the same hands and make the same random choices.
+func NewSeededSource(seed uint64) rand.Source {
+	var b [32]byte
+	binary.LittleEndian.PutUint64(b[:], seed)
+	return rand.NewChaCha8(b)
+}
 
-	return nil
+// ShuffleDeck shuffles a deck in place using rng.
+func ShuffleDeck(rng *rand.Rand, deck []Card) {
+	rng.Shuffle(len(deck), func(i, j int) { deck[i], deck[j] = deck[j], deck[i] })
 }
 
-// Deal shuffles the deck and returns two hands of 7 cards each.
-func Deal() ([7]Card, [7]Card, error) {
-	deck := NewDeck()
-	if err := ShuffleDeck(deck); err != nil {
-		return [7]Card{}, [7]Card{}, err
+// Deal shuffles the cards of def using rng and returns one sorted hand of
+// handSize cards per player.
+func Deal(rng *rand.Rand, def DeckDef, players, handSize int) ([][]Card, error) {
+	deck := def.Cards()
+	if players*handSize > len(deck) {
+		return nil, fmt.Errorf("dealing %d hands of %d: deck has only %d cards", players, handSize, len(deck))
 	}
 
-	var hand1, hand2 [7]Card
-
-	copy(hand1[:], deck[0:7])
-	copy(hand2[:], deck[7:14])
+	ShuffleDeck(rng, deck)
 
-	sort.Slice(hand1[:], func(i, j int) bool { return hand1[i].SortIndex() < hand1[j].SortIndex() })
-	sort.Slice(hand2[:], func(i, j int) bool { return hand2[i].SortIndex() < hand2[j].SortIndex() })
+	hands := make([][]Card, players)
+	for i := range hands {
+		hand := append([]Card(nil), deck[i*handSize:(i+1)*handSize]...)
+		sort.Slice(hand, func(a, b int) bool { return hand[a].SortIndex(def) < hand[b].SortIndex(def) })
+		hands[i] = hand
+	}
 
-	return hand1, hand2, nil
+	return hands, nil
 }
 
 // Phase represents the current phase of the game.
@@ -93,9 +89,6 @@ const (
 	PhaseGameOver      Phase = "game_over"
 )
 
-// BoardSize is the number of slots on the game board.
-const BoardSize = 15
-
 // Preference represents a player's turn order preference.
 type Preference string
 
@@ -115,88 +108,226 @@ func ValidPreference(p string) bool {
 	return false
 }
 
-// Game represents the state of a single game.
+// Game represents the state of a single game. Per-player slices are indexed
+// by playerNumber-1; board slices have Rules.BoardSize slots.
 type Game struct {
+	ID          string // names the game's replay
+	Rules       Rules
+	Daily       bool       // dealt from the daily challenge seed; only these count on the board
+	Deck        DeckDef    // the deck in play; decides the sort order
+	SuitHints   []SuitHint // per player; only set for the partial suit order variant
+	rng         *rand.Rand // every random choice in the game; see random
+	restored    time.Time  // when the game was last restored after a restart; see TurnDeadline
 	Phase       Phase
-	Hands       [2][7]Card
-	Board       [BoardSize]*Card
-	BoardOwner  [BoardSize]int // 0 = empty, 1 or 2 = player who placed
-	FirstPlayer int            // 1 or 2; set after turn order resolution
-	CurrentTurn int            // 1 or 2
-	PassUsed    [2]bool
-	CardsPlaced [2]int        // how many cards each player has placed
-	HandUsed    [2][7]bool    // which cards from each hand have been placed
-	Picks       [2]Preference // turn order preferences (index 0 = player 1)
+	Hands       [][]Card
+	Board       []*Card
+	BoardOwner  []int        // 0 = empty, otherwise the player who placed
+	FirstPlayer int          // set after turn order resolution
+	CurrentTurn int          // player whose turn it is
+	PassesUsed  []int        // how many passes each player has used
+	CardsPlaced []int        // how many cards each player has placed
+	HandUsed    [][]bool     // which cards from each hand have been placed
+	Picks       []Preference // turn order preferences (index 0 = player 1)
 
 	// Swap state
-	SwapsCompleted     int          // 0, 1, or 2 (swap phase turns completed)
+	SwapsCompleted     int          // swap phase turns completed (up to Rules.SwapTurns())
 	SwapPending        bool         // whether a swap suggestion is awaiting response
 	SwapSlots          [2]int       // the two slots in the pending suggestion
-	SwapSuggester      int          // player who made the pending suggestion (1 or 2)
+	SwapSuggester      int          // player who made the pending suggestion
 	SwapSuggestedPhase Phase        // the phase when the pending swap was suggested
-	SwapAccepted       [2]bool      // whether each player has had a swap accepted (max 1 each)
+	SwapsAccepted      []int        // accepted swaps per player (up to Rules.SwapsPerPlayer)
 	SwapHistory        []SwapRecord // accepted swaps for visual indicators
+
+	Signals     []Signal // every signal on the board
+	SignalsUsed []int    // signal tokens each player has spent
+	EmotesSent  []int    // emotes each player sent during the game
+
+	Events []ReplayEvent // every action so far, for replays
 }
 
-// SetPick records a player's turn order preference. playerNumber is 1 or 2.
+// SetPick records a player's turn order preference.
 func (g *Game) SetPick(playerNumber int, pref Preference) {
 	g.Picks[playerNumber-1] = pref
+	g.record(ReplayPick, playerNumber, PickDetails{Preference: pref})
 }
 
-// BothPicked reports whether both players have submitted their turn order preference.
-func (g *Game) BothPicked() bool {
-	return g.Picks[0] != "" && g.Picks[1] != ""
+// AllPicked reports whether every player has submitted their turn order preference.
+func (g *Game) AllPicked() bool {
+	for _, p := range g.Picks {
+		if p == "" {
+			return false
+		}
+	}
+
+	return true
 }
 
 // ResolveTurnOrder resolves the turn order picks. Returns (firstPlayer, conflict).
 // If conflict is true, firstPlayer is 0 and picks should be reset for re-pick.
+//
+// A single "first" pick wins; several are a conflict. Without one, the first
+// player is drawn at random from the neutral picks, and it is a conflict if
+// everyone picked "no_first".
 func (g *Game) ResolveTurnOrder() (int, bool) {
-	p1, p2 := g.Picks[0], g.Picks[1]
+	var first, neutral []int
+	for i, p := range g.Picks {
+		switch p {
+		case PrefFirst:
+			first = append(first, i+1)
+		case PrefNeutral:
+			neutral = append(neutral, i+1)
+		}
+	}
 
 	switch {
-	case p1 == PrefFirst && p2 == PrefFirst:
+	case len(first) == 1:
+		return first[0], false
+	case len(first) > 1:
 		return 0, true
-	case p1 == PrefNoFirst && p2 == PrefNoFirst:
+	case len(neutral) == 0:
 		return 0, true
-	case p1 == PrefFirst && p2 != PrefFirst:
-		return 1, false
-	case p2 == PrefFirst && p1 != PrefFirst:
-		return 2, false
-	case p1 == PrefNoFirst && p2 != PrefNoFirst:
-		return 2, false
-	case p2 == PrefNoFirst && p1 != PrefNoFirst:
-		return 1, false
+	case len(neutral) == 1:
+		return neutral[0], false
 	default:
-		// Both neutral — random pick
-		n, err := rand.Int(rand.Reader, big.NewInt(2))
-		if err != nil {
-			return 1, false // fallback
-		}
+		return neutral[g.random().IntN(len(neutral))], false
+	}
+}
 
-		return int(n.Int64()) + 1, false
+// random returns the game's randomness source. Games built without one,
+// such as test fixtures, get an unpredictable source on first use.
+func (g *Game) random() *rand.Rand {
+	if g.rng == nil {
+		g.rng = rand.New(NewRandomSource())
 	}
+
+	return g.rng
 }
 
-// ResetPicks clears both players' turn order preferences for a re-pick.
+// StartPlacement records the resolved first player and begins the placement phase.
+func (g *Game) StartPlacement(firstPlayer int) {
+	g.FirstPlayer = firstPlayer
+	g.CurrentTurn = firstPlayer
+	g.Phase = PhasePlacement
+	g.record(ReplayPlacementStart, 0, PlacementStartDetails{FirstPlayer: firstPlayer})
+}
+
+// ResetPicks clears every player's turn order preference for a re-pick.
 func (g *Game) ResetPicks() {
-	g.Picks = [2]Preference{}
+	g.Picks = make([]Preference, len(g.Picks))
+	g.record(ReplayConflict, 0, nil)
 }
 
-// NewGame creates a new game, shuffles and deals cards.
-func NewGame() (*Game, error) {
-	hand1, hand2, err := Deal()
+// NewGame creates a new game under rules, shuffles and deals cards.
+func NewGame(rules Rules) (*Game, error) {
+	return NewGameWithSource(rules, NewRandomSource())
+}
+
+// NewGameWithSource creates a new game under rules that takes every random
+// choice (the deal, a secret suit order, turn order draws) from src.
+// Hands are sorted in the public deck order, so a secret suit order is not
+// given away by the deal.
+func NewGameWithSource(rules Rules, src rand.Source) (*Game, error) {
+	rng := rand.New(src)
+	hands, err := Deal(rng, rules.Deck, rules.Players, rules.HandSize)
 	if err != nil {
 		return nil, fmt.Errorf("creating game: %w", err)
 	}
 
-	return &Game{
-		Phase: PhaseTurnOrderPick,
-		Hands: [2][7]Card{hand1, hand2},
-	}, nil
+	g := newGame(rules, hands)
+	g.ID = crand.Text()
+	g.rng = rng
+	if rules.SecretSuitOrder() {
+		g.shuffleSuitOrder()
+	}
+
+	g.record(ReplayDeal, 0, DealDetails{Hands: hands, SuitOrder: g.Deck.Suits, SuitHints: g.SuitHints})
+	return g, nil
+}
+
+// shuffleSuitOrder gives the game a random suit order and, for the partial
+// variant, tells each player how one adjacent pair of suits ranks. Players are
+// told different pairs while there are enough to go around.
+func (g *Game) shuffleSuitOrder() {
+	rng := g.random()
+	suits := append([]Suit(nil), g.Rules.Deck.Suits...)
+	rng.Shuffle(len(suits), func(i, j int) { suits[i], suits[j] = suits[j], suits[i] })
+
+	g.Deck.Suits = suits
+	if g.Rules.SuitOrder != SuitOrderPartial || len(suits) < 2 {
+		return
+	}
+
+	pairs := make([]SuitHint, len(suits)-1)
+	for i := range pairs {
+		pairs[i] = SuitHint{Lower: suits[i], Higher: suits[i+1]}
+	}
+
+	rng.Shuffle(len(pairs), func(i, j int) { pairs[i], pairs[j] = pairs[j], pairs[i] })
+
+	g.SuitHints = make([]SuitHint, len(g.Hands))
+	for i := range g.SuitHints {
+		g.SuitHints[i] = pairs[i%len(pairs)]
+	}
+}
+
+// SuitHintFor returns the suit pair a player was told, or nil if none.
+func (g *Game) SuitHintFor(playerNumber int) *SuitHint {
+	if g.SuitHints == nil {
+		return nil
+	}
+
+	hint := g.SuitHints[playerNumber-1]
+	return &hint
+}
+
+// deckFor returns the sort order a player knows: the real one unless the
+// order is secret, in which case it is the player's best guess.
+func (g *Game) deckFor(playerNumber int) DeckDef {
+	if !g.Rules.SecretSuitOrder() {
+		return g.Deck
+	}
+
+	return g.Rules.Deck.withHint(g.SuitHintFor(playerNumber))
+}
+
+// newGame creates a game in the turn order pick phase with the given hands.
+func newGame(rules Rules, hands [][]Card) *Game {
+	g := &Game{
+		Rules:         rules,
+		Deck:          rules.Deck,
+		Phase:         PhaseTurnOrderPick,
+		Hands:         hands,
+		Board:         make([]*Card, rules.BoardSize),
+		BoardOwner:    make([]int, rules.BoardSize),
+		PassesUsed:    make([]int, len(hands)),
+		CardsPlaced:   make([]int, len(hands)),
+		HandUsed:      make([][]bool, len(hands)),
+		Picks:         make([]Preference, len(hands)),
+		SwapsAccepted: make([]int, len(hands)),
+		SignalsUsed:   make([]int, len(hands)),
+		EmotesSent:    make([]int, len(hands)),
+	}
+
+	for i, hand := range hands {
+		g.HandUsed[i] = make([]bool, len(hand))
+	}
+
+	return g
+}
+
+// HasPass reports whether a player has a pass left.
+func (g *Game) HasPass(playerNumber int) bool {
+	return g.PassesUsed[playerNumber-1] < g.Rules.Passes
+}
+
+// CanSwap reports whether a player may still have a swap accepted.
+func (g *Game) CanSwap(playerNumber int) bool {
+	return g.SwapsAccepted[playerNumber-1] < g.Rules.SwapsPerPlayer
 }
 
 // PlaceCard places a card from a player's hand onto the board.
-// playerNumber is 1 or 2, cardIndex is 0–6, slotIndex is 0–14.
+// playerNumber is 1-based, cardIndex indexes the player's hand and
+// slotIndex indexes the board.
 func (g *Game) PlaceCard(playerNumber, cardIndex, slotIndex int) error {
 	if g.Phase != PhasePlacement {
 		return fmt.Errorf("not in placement phase")
@@ -207,7 +338,7 @@ func (g *Game) PlaceCard(playerNumber, cardIndex, slotIndex int) error {
 	}
 
 	idx := playerNumber - 1
-	if cardIndex < 0 || cardIndex >= 7 {
+	if cardIndex < 0 || cardIndex >= len(g.Hands[idx]) {
 		return fmt.Errorf("invalid card index")
 	}
 
@@ -215,7 +346,7 @@ func (g *Game) PlaceCard(playerNumber, cardIndex, slotIndex int) error {
 		return fmt.Errorf("card already placed")
 	}
 
-	if slotIndex < 0 || slotIndex >= BoardSize {
+	if slotIndex < 0 || slotIndex >= len(g.Board) {
 		return fmt.Errorf("invalid slot index")
 	}
 
@@ -228,12 +359,13 @@ func (g *Game) PlaceCard(playerNumber, cardIndex, slotIndex int) error {
 	g.BoardOwner[slotIndex] = playerNumber
 	g.HandUsed[idx][cardIndex] = true
 	g.CardsPlaced[idx]++
+	g.record(ReplayPlace, playerNumber, PlaceDetails{CardIndex: cardIndex, SlotIndex: slotIndex, Card: card})
 
 	g.advanceTurn()
 	return nil
 }
 
-// UsePass records a player using their single pass.
+// UsePass records a player using one of their passes.
 func (g *Game) UsePass(playerNumber int) error {
 	if g.Phase != PhasePlacement {
 		return fmt.Errorf("not in placement phase")
@@ -243,12 +375,12 @@ func (g *Game) UsePass(playerNumber int) error {
 		return fmt.Errorf("not your turn")
 	}
 
-	idx := playerNumber - 1
-	if g.PassUsed[idx] {
-		return fmt.Errorf("pass already used")
+	if !g.HasPass(playerNumber) {
+		return fmt.Errorf("no passes left")
 	}
 
-	g.PassUsed[idx] = true
+	g.PassesUsed[playerNumber-1]++
+	g.record(ReplayPass, playerNumber, nil)
 	g.advanceTurn()
 
 	return nil
@@ -259,7 +391,7 @@ func (g *Game) Peek(playerNumber, slotIndex int) (*Card, error) {
 	if g.Phase != PhasePlacement {
 		return nil, fmt.Errorf("not in placement phase")
 	}
-	if slotIndex < 0 || slotIndex >= BoardSize {
+	if slotIndex < 0 || slotIndex >= len(g.Board) {
 		return nil, fmt.Errorf("invalid slot index")
 	}
 	if g.Board[slotIndex] == nil {
@@ -269,12 +401,19 @@ func (g *Game) Peek(playerNumber, slotIndex int) (*Card, error) {
 		return nil, fmt.Errorf("not your card")
 	}
 
+	g.record(ReplayPeek, playerNumber, PeekDetails{SlotIndex: slotIndex, Card: *g.Board[slotIndex]})
 	return g.Board[slotIndex], nil
 }
 
-// AllCardsPlaced reports whether all 14 cards have been placed.
+// AllCardsPlaced reports whether every player has placed their whole hand.
 func (g *Game) AllCardsPlaced() bool {
-	return g.CardsPlaced[0] == 7 && g.CardsPlaced[1] == 7
+	for i, placed := range g.CardsPlaced {
+		if placed < len(g.Hands[i]) {
+			return false
+		}
+	}
+
+	return true
 }
 
 // SuggestSwap records a swap suggestion. Allowed during placement (any player)
@@ -292,11 +431,11 @@ func (g *Game) SuggestSwap(playerNumber, slotA, slotB int) error {
 		return fmt.Errorf("a swap is already pending")
 	}
 
-	if g.SwapAccepted[playerNumber-1] {
-		return fmt.Errorf("you have already used your swap")
+	if !g.CanSwap(playerNumber) {
+		return fmt.Errorf("you have no swaps left")
 	}
 
-	if slotA < 0 || slotA >= BoardSize || slotB < 0 || slotB >= BoardSize {
+	if slotA < 0 || slotA >= len(g.Board) || slotB < 0 || slotB >= len(g.Board) {
 		return fmt.Errorf("invalid slot index")
 	}
 
@@ -317,11 +456,13 @@ func (g *Game) SuggestSwap(playerNumber, slotA, slotB int) error {
 	g.SwapSlots = [2]int{slotA, slotB}
 	g.SwapSuggester = playerNumber
 	g.SwapSuggestedPhase = g.Phase
+	g.record(ReplaySuggestSwap, playerNumber, SwapDetails{SlotA: slotA, SlotB: slotB})
 
 	return nil
 }
 
-// RespondSwap handles the other player's response to a pending swap suggestion.
+// RespondSwap handles a response to a pending swap suggestion. Any player
+// other than the suggester may answer; the first answer decides.
 func (g *Game) RespondSwap(playerNumber int, accept bool) error {
 	if g.Phase != PhaseSwap && g.Phase != PhasePlacement {
 		return fmt.Errorf("swaps not allowed in this phase")
@@ -335,11 +476,14 @@ func (g *Game) RespondSwap(playerNumber int, accept bool) error {
 		return fmt.Errorf("cannot respond to your own swap")
 	}
 
+	g.record(ReplayRespondSwap, playerNumber, RespondDetails{Accept: accept})
+
 	if accept {
 		slotA, slotB := g.SwapSlots[0], g.SwapSlots[1]
 		g.Board[slotA], g.Board[slotB] = g.Board[slotB], g.Board[slotA]
 		g.BoardOwner[slotA], g.BoardOwner[slotB] = g.BoardOwner[slotB], g.BoardOwner[slotA]
-		g.SwapAccepted[g.SwapSuggester-1] = true
+		g.swapSignals(slotA, slotB)
+		g.SwapsAccepted[g.SwapSuggester-1]++
 		g.SwapHistory = append(g.SwapHistory, SwapRecord{
 			SlotA:    slotA,
 			SlotB:    slotB,
@@ -370,6 +514,7 @@ func (g *Game) SkipSwap(playerNumber int) error {
 		return fmt.Errorf("a swap is already pending")
 	}
 
+	g.record(ReplaySkipSwap, playerNumber, nil)
 	g.advanceSwap()
 	return nil
 }
@@ -377,38 +522,25 @@ func (g *Game) SkipSwap(playerNumber int) error {
 // advanceSwap moves to the next swap turn or transitions to reveal phase.
 func (g *Game) advanceSwap() {
 	g.SwapsCompleted++
-	if g.SwapsCompleted >= 2 {
-		g.Phase = PhaseReveal
-		return
-	}
-
-	if g.CurrentTurn == 1 {
-		g.CurrentTurn = 2
-	} else {
-		g.CurrentTurn = 1
-	}
-
+	g.CurrentTurn = g.nextPlayer(g.CurrentTurn)
 	g.autoSkipSwaps()
 }
 
-// autoSkipSwaps auto-skips swap turns for players who already used their swap.
+// autoSkipSwaps transitions to the reveal phase once all swap turns are done,
+// and auto-skips swap turns for players who have no swaps left.
 func (g *Game) autoSkipSwaps() {
-	for g.Phase == PhaseSwap && g.SwapsCompleted < 2 {
-		if !g.SwapAccepted[g.CurrentTurn-1] {
+	for g.Phase == PhaseSwap {
+		if g.SwapsCompleted >= g.Rules.SwapTurns() {
+			g.Phase = PhaseReveal
 			return
 		}
 
-		g.SwapsCompleted++
-		if g.SwapsCompleted >= 2 {
-			g.Phase = PhaseReveal
+		if g.CanSwap(g.CurrentTurn) {
 			return
 		}
 
-		if g.CurrentTurn == 1 {
-			g.CurrentTurn = 2
-		} else {
-			g.CurrentTurn = 1
-		}
+		g.SwapsCompleted++
+		g.CurrentTurn = g.nextPlayer(g.CurrentTurn)
 	}
 }
 
@@ -428,7 +560,7 @@ type RevealEntry struct {
 // RevealOrder returns the placed cards in left-to-right board order (by slot index).
 // Each entry contains the slot index and the card at that slot.
 func (g *Game) RevealOrder() []RevealEntry {
-	entries := make([]RevealEntry, 0, 14)
+	entries := make([]RevealEntry, 0, len(g.Board))
 	for i, card := range g.Board {
 		if card != nil {
 			entries = append(entries, RevealEntry{SlotIndex: i, Card: *card})
@@ -444,6 +576,7 @@ func (g *Game) FinalizeReveal() ([]RevealEntry, bool) {
 	order := g.RevealOrder()
 	win := g.CheckWin()
 	g.Phase = PhaseGameOver
+	g.record(ReplayReveal, 0, RevealDetails{Order: order, Win: win})
 
 	return order, win
 }
@@ -452,26 +585,32 @@ func (g *Game) FinalizeReveal() ([]RevealEntry, bool) {
 // Cards are read left to right, skipping empty slots. Returns true if every card's
 // sort index is greater than the previous card's sort index.
 func (g *Game) CheckWin() bool {
+	return g.FailedSlot() == -1
+}
+
+// FailedSlot returns the slot of the first card that is not greater than the
+// card before it, i.e. where CheckWin fails. Returns -1 if the board is ordered.
+func (g *Game) FailedSlot() int {
 	prev := -1
-	for _, card := range g.Board {
+	for slot, card := range g.Board {
 		if card == nil {
 			continue
 		}
-		idx := card.SortIndex()
+		idx := card.SortIndex(g.Deck)
 		if idx <= prev {
-			return false
+			return slot
 		}
 
 		prev = idx
 	}
 
-	return true
+	return -1
 }
 
-// advanceTurn switches the current turn to the other player,
+// advanceTurn passes the turn to the next player in seat order,
 // and transitions to the swap phase if all cards are placed.
-// If the next player has already placed all their cards, their
-// turn is automatically skipped.
+// Players who have already placed all their cards are
+// automatically skipped.
 func (g *Game) advanceTurn() {
 	if g.AllCardsPlaced() {
 		g.Phase = PhaseSwap
@@ -480,19 +619,15 @@ func (g *Game) advanceTurn() {
 		return
 	}
 
-	if g.CurrentTurn == 1 {
-		g.CurrentTurn = 2
-	} else {
-		g.CurrentTurn = 1
-	}
+	g.CurrentTurn = g.nextPlayer(g.CurrentTurn)
 
-	// Auto-skip if the next player has no cards remaining
-	idx := g.CurrentTurn - 1
-	if g.CardsPlaced[idx] == 7 {
-		if g.CurrentTurn == 1 {
-			g.CurrentTurn = 2
-		} else {
-			g.CurrentTurn = 1
-		}
+	// Auto-skip players with no cards remaining
+	for idx := g.CurrentTurn - 1; g.CardsPlaced[idx] == len(g.Hands[idx]); idx = g.CurrentTurn - 1 {
+		g.CurrentTurn = g.nextPlayer(g.CurrentTurn)
 	}
 }
+
+// nextPlayer returns the player who follows playerNumber in turn order.
+func (g *Game) nextPlayer(playerNumber int) int {
+	return playerNumber%len(g.Hands) + 1
+}
@@ -2,6 +2,8 @@ package main
 
 import (
 	"fmt"
+	"math/rand/v2"
+	"reflect"
 	"testing"
 )
 
@@ -56,7 +58,7 @@ func TestSortIndex(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(fmt.Sprintf("%s%d", tt.card.Suit, tt.card.Value), func(t *testing.T) {
-			if got := tt.card.SortIndex(); got != tt.index {
+			if got := tt.card.SortIndex(DefaultDeck()); got != tt.index {
 				t.Errorf("SortIndex() = %d, want %d", got, tt.index)
 			}
 		})
@@ -66,9 +68,9 @@ func TestSortIndex(t *testing.T) {
 func TestSortOrderIsMonotonic(t *testing.T) {
 	deck := NewDeck()
 	for i := 1; i < len(deck); i++ {
-		if deck[i].SortIndex() <= deck[i-1].SortIndex() {
+		if deck[i].SortIndex(DefaultDeck()) <= deck[i-1].SortIndex(DefaultDeck()) {
 			t.Errorf("sort order not monotonic at index %d: %v (%d) <= %v (%d)",
-				i, deck[i], deck[i].SortIndex(), deck[i-1], deck[i-1].SortIndex())
+				i, deck[i], deck[i].SortIndex(DefaultDeck()), deck[i-1], deck[i-1].SortIndex(DefaultDeck()))
 		}
 	}
 }
@@ -76,9 +78,7 @@ func TestSortOrderIsMonotonic(t *testing.T) {
 func TestShuffleDeck(t *testing.T) {
 	deck1 := NewDeck()
 	deck2 := NewDeck()
-	if err := ShuffleDeck(deck2); err != nil {
-		t.Fatalf("ShuffleDeck error: %v", err)
-	}
+	ShuffleDeck(rand.New(NewRandomSource()), deck2)
 
 	same := true
 	for i := range deck1 {
@@ -102,11 +102,13 @@ func TestShuffleDeck(t *testing.T) {
 }
 
 func TestDeal(t *testing.T) {
-	hand1, hand2, err := Deal()
+	hands, err := Deal(rand.New(NewRandomSource()), DefaultDeck(), 2, 7)
 	if err != nil {
 		t.Fatalf("Deal error: %v", err)
 	}
 
+	hand1, hand2 := hands[0], hands[1]
+
 	t.Run("each hand has 7 cards", func(t *testing.T) {
 		if len(hand1) != 7 {
 			t.Errorf("hand1: expected 7 cards, got %d", len(hand1))
@@ -129,7 +131,7 @@ func TestDeal(t *testing.T) {
 	})
 
 	t.Run("all cards are valid", func(t *testing.T) {
-		for _, hand := range [][7]Card{hand1, hand2} {
+		for _, hand := range hands {
 			for _, c := range hand {
 				if c.Suit == "" || c.Value < 1 || c.Value > 10 {
 					t.Errorf("invalid card: %v", c)
@@ -139,8 +141,57 @@ func TestDeal(t *testing.T) {
 	})
 }
 
+func TestDealTooManyCards(t *testing.T) {
+	if _, err := Deal(rand.New(NewRandomSource()), DefaultDeck(), 2, 21); err == nil {
+		t.Error("expected error when hands exceed the deck")
+	}
+}
+
+func TestSeededGamesMatch(t *testing.T) {
+	rules := DefaultRules()
+	rules.SuitOrder = SuitOrderPartial
+
+	a, err := NewGameWithSource(rules, NewSeededSource(42))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	b, err := NewGameWithSource(rules, NewSeededSource(42))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !reflect.DeepEqual(a.Hands, b.Hands) {
+		t.Error("expected the same seed to deal the same hands")
+	}
+
+	if !reflect.DeepEqual(a.Deck, b.Deck) || !reflect.DeepEqual(a.SuitHints, b.SuitHints) {
+		t.Error("expected the same seed to pick the same secret suit order")
+	}
+
+	for _, g := range []*Game{a, b} {
+		g.SetPick(1, PrefNeutral)
+		g.SetPick(2, PrefNeutral)
+	}
+
+	firstA, _ := a.ResolveTurnOrder()
+	firstB, _ := b.ResolveTurnOrder()
+	if firstA != firstB {
+		t.Errorf("expected the same seed to draw the same first player, got %d and %d", firstA, firstB)
+	}
+
+	c, err := NewGameWithSource(rules, NewSeededSource(43))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if reflect.DeepEqual(a.Hands, c.Hands) {
+		t.Error("expected different seeds to deal different hands")
+	}
+}
+
 func TestNewGame(t *testing.T) {
-	game, err := NewGame()
+	game, err := NewGame(DefaultRules())
 	if err != nil {
 		t.Fatalf("NewGame error: %v", err)
 	}
@@ -169,6 +220,10 @@ func TestNewGame(t *testing.T) {
 		}
 	}
 
+	if len(game.Board) != 15 || len(game.Hands[0]) != 7 {
+		t.Errorf("expected 15 slots and 7-card hands, got %d and %d", len(game.Board), len(game.Hands[0]))
+	}
+
 	// Board should be empty
 	for i, slot := range game.Board {
 		if slot != nil {
@@ -177,6 +232,68 @@ func TestNewGame(t *testing.T) {
 	}
 }
 
+func TestSecretSuitOrder(t *testing.T) {
+	t.Run("fixed order keeps the deck", func(t *testing.T) {
+		g, err := NewGame(DefaultRules())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(g.Deck, DefaultDeck()) || g.SuitHints != nil {
+			t.Errorf("expected the default deck without hints, got %v / %v", g.Deck, g.SuitHints)
+		}
+	})
+
+	t.Run("hidden order shuffles suits only", func(t *testing.T) {
+		rules := DefaultRules()
+		rules.SuitOrder = SuitOrderHidden
+
+		g, err := NewGame(rules)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if !reflect.DeepEqual(rules.Deck, DefaultDeck()) {
+			t.Fatal("shuffling the game's suits changed the room's deck")
+		}
+
+		if len(g.Deck.Suits) != 4 || g.Deck.Validate() != nil {
+			t.Errorf("expected a permutation of the four suits, got %v", g.Deck.Suits)
+		}
+
+		if g.SuitHints != nil || g.SuitHintFor(1) != nil {
+			t.Error("hidden order should not give hints")
+		}
+	})
+
+	t.Run("partial order gives each player a different true pair", func(t *testing.T) {
+		rules := DefaultRules()
+		rules.SuitOrder = SuitOrderPartial
+
+		g, err := NewGame(rules)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		h1, h2 := g.SuitHintFor(1), g.SuitHintFor(2)
+		if h1 == nil || h2 == nil || *h1 == *h2 {
+			t.Fatalf("expected two different hints, got %v and %v", h1, h2)
+		}
+
+		for player := 1; player <= 2; player++ {
+			h := g.SuitHintFor(player)
+			if g.Deck.suitRank(h.Higher)-g.Deck.suitRank(h.Lower) != 1 {
+				t.Errorf("hint %v is not an adjacent pair of %v", *h, g.Deck.Suits)
+			}
+
+			guess := g.ViewFor(player).Deck
+			if guess.suitRank(h.Lower) > guess.suitRank(h.Higher) {
+				t.Errorf("player %d's view order %v contradicts hint %v", player, guess.Suits, *h)
+			}
+		}
+	})
+}
+
 func TestValidPreference(t *testing.T) {
 	tests := []struct {
 		input string
@@ -201,23 +318,26 @@ func TestValidPreference(t *testing.T) {
 func TestResolveTurnOrder(t *testing.T) {
 	tests := []struct {
 		name        string
-		pick1       Preference
-		pick2       Preference
+		picks       []Preference
 		wantFirst   int
 		wantConflct bool
 	}{
-		{"both first = conflict", PrefFirst, PrefFirst, 0, true},
-		{"both no_first = conflict", PrefNoFirst, PrefNoFirst, 0, true},
-		{"first vs neutral", PrefFirst, PrefNeutral, 1, false},
-		{"first vs no_first", PrefFirst, PrefNoFirst, 1, false},
-		{"neutral vs first", PrefNeutral, PrefFirst, 2, false},
-		{"no_first vs first", PrefNoFirst, PrefFirst, 2, false},
-		{"no_first vs neutral", PrefNoFirst, PrefNeutral, 2, false},
-		{"neutral vs no_first", PrefNeutral, PrefNoFirst, 1, false},
+		{"both first = conflict", []Preference{PrefFirst, PrefFirst}, 0, true},
+		{"both no_first = conflict", []Preference{PrefNoFirst, PrefNoFirst}, 0, true},
+		{"first vs neutral", []Preference{PrefFirst, PrefNeutral}, 1, false},
+		{"first vs no_first", []Preference{PrefFirst, PrefNoFirst}, 1, false},
+		{"neutral vs first", []Preference{PrefNeutral, PrefFirst}, 2, false},
+		{"no_first vs first", []Preference{PrefNoFirst, PrefFirst}, 2, false},
+		{"no_first vs neutral", []Preference{PrefNoFirst, PrefNeutral}, 2, false},
+		{"neutral vs no_first", []Preference{PrefNeutral, PrefNoFirst}, 1, false},
+		{"three players, one first", []Preference{PrefNeutral, PrefNoFirst, PrefFirst}, 3, false},
+		{"three players, two first", []Preference{PrefFirst, PrefNeutral, PrefFirst}, 0, true},
+		{"three players, all no_first", []Preference{PrefNoFirst, PrefNoFirst, PrefNoFirst}, 0, true},
+		{"four players, one neutral", []Preference{PrefNoFirst, PrefNoFirst, PrefNeutral, PrefNoFirst}, 3, false},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			game := &Game{Picks: [2]Preference{tt.pick1, tt.pick2}}
+			game := &Game{Picks: tt.picks}
 			first, conflict := game.ResolveTurnOrder()
 			if conflict != tt.wantConflct {
 				t.Errorf("conflict = %v, want %v", conflict, tt.wantConflct)
@@ -231,7 +351,7 @@ func TestResolveTurnOrder(t *testing.T) {
 
 func TestResolveTurnOrderBothNeutral(t *testing.T) {
 	// Both neutral should randomly pick 1 or 2 without conflict
-	game := &Game{Picks: [2]Preference{PrefNeutral, PrefNeutral}}
+	game := &Game{Picks: []Preference{PrefNeutral, PrefNeutral}}
 	first, conflict := game.ResolveTurnOrder()
 
 	if conflict {
@@ -243,43 +363,64 @@ func TestResolveTurnOrderBothNeutral(t *testing.T) {
 	}
 }
 
-func TestSetPickAndBothPicked(t *testing.T) {
-	game := &Game{}
+func TestResolveTurnOrderOnlyNeutralsCanStart(t *testing.T) {
+	// Players who picked no_first are never drawn
+	game := &Game{Picks: []Preference{PrefNoFirst, PrefNeutral, PrefNeutral, PrefNoFirst}}
+
+	for i := 0; i < 50; i++ {
+		first, conflict := game.ResolveTurnOrder()
+		if conflict {
+			t.Fatal("neutral picks should not be a conflict")
+		}
+
+		if first != 2 && first != 3 {
+			t.Fatalf("firstPlayer should be 2 or 3, got %d", first)
+		}
+	}
+}
+
+func TestSetPickAndAllPicked(t *testing.T) {
+	game := newGame(DefaultRules(), [][]Card{nil, nil, nil})
 
-	if game.BothPicked() {
-		t.Error("BothPicked should be false before any picks")
+	if game.AllPicked() {
+		t.Error("AllPicked should be false before any picks")
 	}
 
 	game.SetPick(1, PrefFirst)
-	if game.BothPicked() {
-		t.Error("BothPicked should be false with only one pick")
+	game.SetPick(3, PrefNoFirst)
+	if game.AllPicked() {
+		t.Error("AllPicked should be false with a pick missing")
 	}
 
 	game.SetPick(2, PrefNeutral)
-	if !game.BothPicked() {
-		t.Error("BothPicked should be true after both picks")
+	if !game.AllPicked() {
+		t.Error("AllPicked should be true after every pick")
 	}
 }
 
 func TestResetPicks(t *testing.T) {
-	game := &Game{Picks: [2]Preference{PrefFirst, PrefNoFirst}}
+	game := &Game{Picks: []Preference{PrefFirst, PrefNoFirst, PrefNeutral}}
 	game.ResetPicks()
 
-	if game.Picks[0] != "" || game.Picks[1] != "" {
-		t.Error("ResetPicks should clear both picks")
+	if len(game.Picks) != 3 {
+		t.Fatalf("ResetPicks should keep one pick per player, got %d", len(game.Picks))
+	}
+
+	for i, p := range game.Picks {
+		if p != "" {
+			t.Errorf("pick %d = %q, want cleared", i+1, p)
+		}
 	}
 }
 
 func newTestGame() *Game {
-	return &Game{
-		Phase: PhasePlacement,
-		Hands: [2][7]Card{
-			{Card{Hearts, 1}, Card{Hearts, 2}, Card{Hearts, 3}, Card{Hearts, 4}, Card{Hearts, 5}, Card{Hearts, 6}, Card{Hearts, 7}},
-			{Card{Spades, 1}, Card{Spades, 2}, Card{Spades, 3}, Card{Spades, 4}, Card{Spades, 5}, Card{Spades, 6}, Card{Spades, 7}},
-		},
-		FirstPlayer: 1,
-		CurrentTurn: 1,
-	}
+	g := newGame(DefaultRules(), [][]Card{
+		{Card{Hearts, 1}, Card{Hearts, 2}, Card{Hearts, 3}, Card{Hearts, 4}, Card{Hearts, 5}, Card{Hearts, 6}, Card{Hearts, 7}},
+		{Card{Spades, 1}, Card{Spades, 2}, Card{Spades, 3}, Card{Spades, 4}, Card{Spades, 5}, Card{Spades, 6}, Card{Spades, 7}},
+	})
+	g.StartPlacement(1)
+
+	return g
 }
 
 func TestPlaceCard(t *testing.T) {
@@ -402,7 +543,7 @@ func TestUsePass(t *testing.T) {
 			t.Fatalf("unexpected error: %v", err)
 		}
 
-		if !g.PassUsed[0] {
+		if g.PassesUsed[0] != 1 {
 			t.Error("expected pass to be used")
 		}
 
@@ -446,17 +587,17 @@ func TestAutoSkipEmptyHand(t *testing.T) {
 
 		// Player 2 uses pass, then both alternate placing cards.
 		// After player 1 places all 7, it should auto-skip back to player 2.
-		g.PlaceCard(1, 0, 0) // P1: 1, turn → P2
-		g.UsePass(2)         // P2: 0, turn → P1
-		g.PlaceCard(1, 1, 1) // P1: 2, turn → P2
-		g.PlaceCard(2, 0, 2) // P2: 1, turn → P1
-		g.PlaceCard(1, 2, 3) // P1: 3, turn → P2
-		g.PlaceCard(2, 1, 4) // P2: 2, turn → P1
-		g.PlaceCard(1, 3, 5) // P1: 4, turn → P2
-		g.PlaceCard(2, 2, 6) // P2: 3, turn → P1
-		g.PlaceCard(1, 4, 7) // P1: 5, turn → P2
-		g.PlaceCard(2, 3, 8) // P2: 4, turn → P1
-		g.PlaceCard(1, 5, 9) // P1: 6, turn → P2
+		g.PlaceCard(1, 0, 0)  // P1: 1, turn → P2
+		g.UsePass(2)          // P2: 0, turn → P1
+		g.PlaceCard(1, 1, 1)  // P1: 2, turn → P2
+		g.PlaceCard(2, 0, 2)  // P2: 1, turn → P1
+		g.PlaceCard(1, 2, 3)  // P1: 3, turn → P2
+		g.PlaceCard(2, 1, 4)  // P2: 2, turn → P1
+		g.PlaceCard(1, 3, 5)  // P1: 4, turn → P2
+		g.PlaceCard(2, 2, 6)  // P2: 3, turn → P1
+		g.PlaceCard(1, 4, 7)  // P1: 5, turn → P2
+		g.PlaceCard(2, 3, 8)  // P2: 4, turn → P1
+		g.PlaceCard(1, 5, 9)  // P1: 6, turn → P2
 		g.PlaceCard(2, 4, 10) // P2: 5, turn → P1
 
 		// Player 1 places their 7th and final card
@@ -486,6 +627,78 @@ func TestAutoSkipEmptyHand(t *testing.T) {
 	})
 }
 
+func TestThreePlayerRotation(t *testing.T) {
+	rules := DefaultRules()
+	rules.Players, rules.HandSize, rules.Passes = 3, 2, 2
+	g := newGame(rules, [][]Card{
+		{Card{Hearts, 1}, Card{Hearts, 2}},
+		{Card{Spades, 1}, Card{Spades, 2}},
+		{Card{Clubs, 1}, Card{Clubs, 2}},
+	})
+	g.StartPlacement(1)
+
+	steps := []struct {
+		player   int
+		pass     bool
+		card     int
+		wantNext int
+	}{
+		{player: 1, pass: true, wantNext: 2},
+		{player: 2, card: 0, wantNext: 3},
+		{player: 3, card: 0, wantNext: 1},
+		{player: 1, pass: true, wantNext: 2},
+		{player: 2, card: 1, wantNext: 3},
+		{player: 3, card: 1, wantNext: 1},
+		{player: 1, card: 0, wantNext: 1}, // players 2 and 3 are done and skipped
+	}
+	for i, s := range steps {
+		var err error
+		if s.pass {
+			err = g.UsePass(s.player)
+		} else {
+			err = g.PlaceCard(s.player, s.card, i)
+		}
+
+		if err != nil {
+			t.Fatalf("step %d: unexpected error: %v", i, err)
+		}
+
+		if g.CurrentTurn != s.wantNext {
+			t.Fatalf("step %d: expected player %d's turn, got %d", i, s.wantNext, g.CurrentTurn)
+		}
+	}
+
+	// Player 1 places the last card; the swap phase starts with the first player
+	if err := g.PlaceCard(1, 1, 10); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if g.Phase != PhaseSwap || g.CurrentTurn != 1 {
+		t.Fatalf("expected swap phase on player 1's turn, got %s on %d", g.Phase, g.CurrentTurn)
+	}
+
+	// Any other player may answer a suggestion
+	if err := g.SuggestSwap(1, 1, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := g.RespondSwap(3, true); err != nil {
+		t.Fatalf("expected player 3 to be able to respond, got %v", err)
+	}
+
+	for _, p := range []int{2, 3} {
+		if g.CurrentTurn != p {
+			t.Fatalf("expected player %d's swap turn, got %d", p, g.CurrentTurn)
+		}
+
+		g.SkipSwap(p)
+	}
+
+	if g.Phase != PhaseReveal {
+		t.Errorf("expected reveal after one swap turn each, got %s", g.Phase)
+	}
+}
+
 func TestPeek(t *testing.T) {
 	t.Run("valid peek own card", func(t *testing.T) {
 		g := newTestGame()
@@ -543,7 +756,7 @@ func TestAllCardsPlaced(t *testing.T) {
 		t.Error("should not be all placed initially")
 	}
 
-	g.CardsPlaced = [2]int{7, 7}
+	g.CardsPlaced = []int{7, 7}
 	if !g.AllCardsPlaced() {
 		t.Error("should be all placed with 7 each")
 	}
@@ -786,7 +999,7 @@ func TestSwapPhaseWithSuggestions(t *testing.T) {
 
 func TestCheckWin(t *testing.T) {
 	t.Run("correctly sorted board wins", func(t *testing.T) {
-		g := &Game{}
+		g := newGame(DefaultRules(), nil)
 		// Place cards in perfect sorted order: H1..H7 in slots 0..6, S1..S7 in slots 7..13
 		cards := []Card{
 			{Hearts, 1}, {Hearts, 2}, {Hearts, 3}, {Hearts, 4},
@@ -806,7 +1019,7 @@ func TestCheckWin(t *testing.T) {
 	})
 
 	t.Run("incorrectly sorted board loses", func(t *testing.T) {
-		g := &Game{}
+		g := newGame(DefaultRules(), nil)
 		// Place cards out of order
 		cards := []Card{
 			{Hearts, 2}, {Hearts, 1}, {Hearts, 3}, {Hearts, 4},
@@ -826,7 +1039,7 @@ func TestCheckWin(t *testing.T) {
 	})
 
 	t.Run("skips empty slots", func(t *testing.T) {
-		g := &Game{}
+		g := newGame(DefaultRules(), nil)
 		// Place 14 cards with one empty slot (slot 7)
 		cards := []Card{
 			{Hearts, 1}, {Hearts, 2}, {Hearts, 3}, {Hearts, 4},
@@ -852,7 +1065,7 @@ func TestCheckWin(t *testing.T) {
 	})
 
 	t.Run("empty slot between out-of-order cards still loses", func(t *testing.T) {
-		g := &Game{}
+		g := newGame(DefaultRules(), nil)
 		// S1 in slot 0, empty slot 1, H1 in slot 2 — S1 > H1 in sort order
 		s1 := Card{Spades, 1}
 		h1 := Card{Hearts, 1}
@@ -866,7 +1079,7 @@ func TestCheckWin(t *testing.T) {
 	})
 
 	t.Run("single card always wins", func(t *testing.T) {
-		g := &Game{}
+		g := newGame(DefaultRules(), nil)
 		card := Card{Diamonds, 5}
 		g.Board[7] = &card
 
@@ -876,7 +1089,7 @@ func TestCheckWin(t *testing.T) {
 	})
 
 	t.Run("empty board wins", func(t *testing.T) {
-		g := &Game{}
+		g := newGame(DefaultRules(), nil)
 		if !g.CheckWin() {
 			t.Error("expected win with empty board")
 		}
@@ -932,7 +1145,7 @@ func TestSuggestSwapDuringPlacement(t *testing.T) {
 			t.Error("cards were not swapped")
 		}
 
-		if !g.SwapAccepted[0] {
+		if g.SwapsAccepted[0] != 1 {
 			t.Error("expected swap accepted for player 1")
 		}
 
@@ -955,7 +1168,7 @@ func TestSuggestSwapDuringPlacement(t *testing.T) {
 			t.Fatalf("unexpected error: %v", err)
 		}
 
-		if g.SwapAccepted[0] {
+		if g.SwapsAccepted[0] != 0 {
 			t.Error("swap should not be accepted on reject")
 		}
 
@@ -998,7 +1211,7 @@ func TestSuggestSwapDuringPlacement(t *testing.T) {
 func TestSwapAcceptedLimit(t *testing.T) {
 	t.Run("cannot suggest when swap already accepted", func(t *testing.T) {
 		g := newSwapTestGame()
-		g.SwapAccepted[0] = true
+		g.SwapsAccepted[0] = 1
 
 		if err := g.SuggestSwap(1, 0, 1); err == nil {
 			t.Error("expected error when swap already accepted")
@@ -1007,7 +1220,7 @@ func TestSwapAcceptedLimit(t *testing.T) {
 
 	t.Run("second player can still suggest", func(t *testing.T) {
 		g := newSwapTestGame()
-		g.SwapAccepted[0] = true
+		g.SwapsAccepted[0] = 1
 		g.SkipSwap(1)
 
 		if err := g.SuggestSwap(2, 0, 1); err != nil {
@@ -1019,7 +1232,7 @@ func TestSwapAcceptedLimit(t *testing.T) {
 func TestAutoSkipSwaps(t *testing.T) {
 	t.Run("auto-skip player with accepted swap", func(t *testing.T) {
 		g := newTestGame()
-		g.SwapAccepted[0] = true
+		g.SwapsAccepted[0] = 1
 
 		for i := 0; i < 7; i++ {
 			g.PlaceCard(1, i, i*2)
@@ -1041,7 +1254,7 @@ func TestAutoSkipSwaps(t *testing.T) {
 
 	t.Run("both swaps accepted skips swap phase", func(t *testing.T) {
 		g := newTestGame()
-		g.SwapAccepted = [2]bool{true, true}
+		g.SwapsAccepted = []int{1, 1}
 
 		for i := 0; i < 7; i++ {
 			g.PlaceCard(1, i, i*2)
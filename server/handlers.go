@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"log/slog"
+	"math"
 	"strings"
+	"time"
 	"unicode/utf8"
 )
 
@@ -23,24 +25,59 @@ func validateName(name string) (string, error) {
 }
 
 func (c *Client) handleCreateRoom(raw []byte) {
-	var msg CreateRoomMsg
+	// Rules start from the defaults; the creator only sends what they change.
+	msg := CreateRoomMsg{Rules: DefaultRules()}
 	if err := json.Unmarshal(raw, &msg); err != nil {
 		c.SendMsg(newError("invalid create_room message"))
 		return
 	}
 
+	// Everyone plays the daily challenge under the same rules
+	if msg.Rules.Daily {
+		msg.Rules = DailyRules()
+	}
+
 	name, err := validateName(msg.Name)
 	if err != nil {
 		c.SendMsg(newError(err.Error()))
 		return
 	}
 
+	if err := msg.Rules.Validate(); err != nil {
+		c.SendMsg(newError("invalid rules: " + err.Error()))
+		return
+	}
+
 	if c.room != nil {
 		c.SendMsg(newError("already in a room"))
 		return
 	}
 
-	room, err := c.rooms.CreateRoom()
+	var strategy Strategy
+	if msg.Bot {
+		name := msg.BotStrategy
+		if name == "" {
+			name = defaultStrategy
+		}
+
+		strategy, err = NewStrategy(name)
+		if err != nil {
+			c.SendMsg(newError(err.Error()))
+			return
+		}
+	}
+
+	if c.rooms.Draining() {
+		c.SendMsg(newError("server is shutting down"))
+		return
+	}
+
+	if _, ok := c.rooms.EmotePack(msg.Rules.Emotes); !ok {
+		c.SendMsg(newError("invalid rules: unknown emote pack"))
+		return
+	}
+
+	room, err := c.rooms.CreateRoom(msg.Rules)
 	if err != nil {
 		c.SendMsg(newError("failed to create room"))
 		slog.Error("failed to create room", "error", err)
@@ -65,7 +102,16 @@ func (c *Client) handleCreateRoom(raw []byte) {
 		Type:         "room_created",
 		RoomCode:     room.Code,
 		PlayerNumber: c.playerNumber,
+		Token:        room.SessionToken(c.playerNumber),
 	})
+
+	// Bots fill every remaining seat
+	for seat := 1; msg.Bot && seat < room.Rules.Players; seat++ {
+		if !addBot(c.rooms, room, strategy) {
+			c.SendMsg(newError("failed to add bot partner"))
+			return
+		}
+	}
 }
 
 func (c *Client) handleJoinRoom(raw []byte) {
@@ -98,9 +144,20 @@ func (c *Client) handleJoinRoom(raw []byte) {
 		return
 	}
 
+	if msg.Spectate {
+		c.joinAsSpectator(room, name)
+		return
+	}
+
+	// Taking the last seat starts a game, which a draining server must not
+	if c.rooms.Draining() && room.OpenSeats() == 1 {
+		c.SendMsg(newError("server is shutting down"))
+		return
+	}
+
 	playerNum, err := room.AddPlayer(c, name)
 	if err != nil {
-		c.SendMsg(newError("room is full"))
+		c.SendMsg(newError(err.Error()))
 		return
 	}
 
@@ -108,37 +165,70 @@ func (c *Client) handleJoinRoom(raw []byte) {
 	c.room = room
 	c.playerNumber = playerNum
 
-	partner := room.Partner(c)
-	partnerName := ""
-	if partner != nil {
-		partnerName = partner.name
+	slog.Info("player joined room", "player", c.name, "room", room.Code)
+
+	room.mu.Lock()
+	players := room.playersLocked()
+	for i, p := range players {
+		if p != nil {
+			p.SendMsg(room.playerJoinedLocked(i + 1))
+		}
 	}
 
-	slog.Info("player joined room", "player", c.name, "room", room.Code)
+	full := room.fullLocked()
+	room.mu.Unlock()
+
+	if !full {
+		room.sendSpectatorState()
+		return
+	}
+
+	// Every seat taken — start the game
+	game, err := room.StartGame()
+	if err != nil {
+		slog.Error("failed to start game", "error", err, "room", room.Code)
+		return
+	}
+
+	slog.Info("game started", "room", room.Code, "phase", game.Phase)
+	sendTurnOrderPrompts(players, game)
+	room.sendSpectatorState()
+}
+
+// playerJoinedLocked builds the player_joined message for the player in seat
+// playerNum from the current seats. The caller must hold the room lock.
+func (r *Room) playerJoinedLocked(playerNum int) PlayerJoinedMsg {
+	names := r.namesLocked()
+
+	partnerName := ""
+	for i, name := range names {
+		if i+1 != playerNum && name != "" {
+			partnerName = name
+			break
+		}
+	}
 
-	c.SendMsg(PlayerJoinedMsg{
+	return PlayerJoinedMsg{
 		Type:         "player_joined",
-		PlayerName:   c.name,
-		PlayerNumber: c.playerNumber,
+		PlayerName:   names[playerNum-1],
+		PlayerNumber: playerNum,
 		PartnerName:  partnerName,
-	})
-
-	if partner != nil {
-		partner.SendMsg(PlayerJoinedMsg{
-			Type:         "player_joined",
-			PlayerName:   partner.name,
-			PlayerNumber: partner.playerNumber,
-			PartnerName:  c.name,
-		})
+		Players:      names,
+		Rules:        r.Rules,
+		Emotes:       r.emotes,
+		Token:        r.tokens[playerNum-1],
+	}
+}
 
-		// Both players present — start the game
-		game, err := room.StartGame()
-		if err != nil {
-			slog.Error("failed to start game", "error", err, "room", room.Code)
-		} else {
-			slog.Info("game started", "room", room.Code, "phase", game.Phase)
-			c.SendMsg(TurnOrderPromptMsg{Type: "turn_order_prompt", Hand: game.Hands[c.playerNumber-1][:]})
-			partner.SendMsg(TurnOrderPromptMsg{Type: "turn_order_prompt", Hand: game.Hands[partner.playerNumber-1][:]})
+// sendTurnOrderPrompts sends each player their hand with the turn order prompt.
+func sendTurnOrderPrompts(players []*Outbox, game *Game) {
+	for i, p := range players {
+		if p != nil {
+			p.SendMsg(TurnOrderPromptMsg{
+				Type:     "turn_order_prompt",
+				Hand:     game.Hands[i][:],
+				SuitHint: game.SuitHintFor(i + 1),
+			})
 		}
 	}
 }
@@ -173,7 +263,7 @@ func (c *Client) handleReconnect(raw []byte) {
 		return
 	}
 
-	playerNum, ok := room.ReconnectPlayer(c, name)
+	playerNum, ok := room.ReconnectPlayer(c, name, msg.Token)
 	if !ok {
 		c.SendMsg(newError("reconnection failed — no matching disconnected player"))
 		return
@@ -181,146 +271,56 @@ func (c *Client) handleReconnect(raw []byte) {
 
 	slog.Info("player reconnected", "player", c.name, "room", room.Code)
 
-	// Notify partner
-	if partner := room.Partner(c); partner != nil {
-		partner.SendMsg(PlayerReconnectedMsg{
-			Type:       "player_reconnected",
-			PlayerName: c.name,
-		})
+	// Notify partners and spectators
+	broadcast(room.Others(c), PlayerReconnectedMsg{
+		Type:       "player_reconnected",
+		PlayerName: c.name,
+	})
+
+	// Resume from the last message the client saw, with a fresh player_joined
+	// for the rotated session token. Fall back to the full game state if the
+	// client sent no sequence number or missed too much.
+	if c.out.Resume(c, msg.LastSeq) {
+		room.mu.Lock()
+		c.SendMsg(room.playerJoinedLocked(playerNum))
+		room.mu.Unlock()
+		return
 	}
 
-	// Send full game state to the reconnecting player
 	sendGameState(c, room, playerNum)
 }
 
-// sendGameState sends the current game state to a reconnecting player.
+// sendGameState sends the current game state to a reconnecting player:
+// player_joined to restore the seat and session token, then one game_state
+// snapshot with everything else.
 func sendGameState(c *Client, room *Room, playerNum int) {
 	room.mu.Lock()
 	defer room.mu.Unlock()
 
-	game := room.Game
-	partner := room.Players[0]
-	if partner == c {
-		partner = room.Players[1]
-	}
+	c.SendMsg(room.playerJoinedLocked(playerNum))
+	c.SendMsg(room.gameStateLocked(playerNum))
 
-	partnerName := ""
-	if partner != nil {
-		partnerName = partner.name
+	if room.daily != nil && room.Game != nil && room.Game.Daily && room.Game.Phase == PhaseGameOver {
+		date := DailyDate(room.Created)
+		c.SendMsg(DailyResultsMsg{Type: "daily_results", Date: date, Results: room.daily.Results(date)})
 	}
+}
 
-	// Send player_joined to restore room/player info
-	c.SendMsg(PlayerJoinedMsg{
-		Type:         "player_joined",
-		PlayerName:   c.name,
-		PlayerNumber: playerNum,
-		PartnerName:  partnerName,
-	})
-
-	if game == nil {
+func (c *Client) handleRequestState() {
+	if c.room == nil {
+		c.SendMsg(newError("no active room"))
 		return
 	}
 
-	hand := game.Hands[playerNum-1][:]
-	handUsed := game.HandUsed[playerNum-1][:]
-
-	// Send game_start only for placement and later phases.
-	// During turn_order_pick, the hand is sent via turn_order_prompt below instead.
-	if game.Phase != PhaseTurnOrderPick {
-		c.SendMsg(GameStartMsg{
-			Type:        "game_start",
-			Hand:        hand,
-			FirstPlayer: game.FirstPlayer,
-			HandUsed:    handUsed,
-		})
-	}
-
-	// Send board state — placed cards
-	for i := 0; i < BoardSize; i++ {
-		if game.Board[i] != nil {
-			c.SendMsg(CardPlacedMsg{
-				Type:      "card_placed",
-				SlotIndex: i,
-				ByPlayer:  game.BoardOwner[i],
-			})
-		}
-	}
-
-	// Send pass usage
-	for i := 0; i < 2; i++ {
-		if game.PassUsed[i] {
-			c.SendMsg(PlayerPassedMsg{
-				Type:     "player_passed",
-				ByPlayer: i + 1,
-			})
-		}
-	}
+	c.room.mu.Lock()
+	defer c.room.mu.Unlock()
 
-	// Send swap history
-	for _, swap := range game.SwapHistory {
-		c.SendMsg(SwapResultMsg{
-			Type:     "swap_result",
-			Accepted: true,
-			SlotA:    swap.SlotA,
-			SlotB:    swap.SlotB,
-			ByPlayer: swap.ByPlayer,
-		})
+	if c.spectator {
+		c.SendMsg(c.room.spectatorStateLocked())
+		return
 	}
 
-	// Send phase-specific state
-	switch game.Phase {
-	case PhaseTurnOrderPick:
-		c.SendMsg(TurnOrderPromptMsg{Type: "turn_order_prompt", Hand: hand})
-
-	case PhasePlacement:
-		if game.CurrentTurn == playerNum {
-			c.SendMsg(YourTurnMsg{Type: "your_turn"})
-		}
-
-		if game.SwapPending {
-			c.SendMsg(SwapSuggestedMsg{
-				Type:     "swap_suggested",
-				SlotA:    game.SwapSlots[0],
-				SlotB:    game.SwapSlots[1],
-				ByPlayer: game.SwapSuggester,
-			})
-		}
-
-	case PhaseSwap:
-		c.SendMsg(SwapPromptMsg{Type: "swap_prompt", ByPlayer: game.CurrentTurn})
-
-		if game.SwapPending {
-			c.SendMsg(SwapSuggestedMsg{
-				Type:     "swap_suggested",
-				SlotA:    game.SwapSlots[0],
-				SlotB:    game.SwapSlots[1],
-				ByPlayer: game.SwapSuggester,
-			})
-		}
-
-	case PhaseGameOver:
-		revealOrder := game.RevealOrder()
-		for _, entry := range revealOrder {
-			c.SendMsg(RevealCardMsg{
-				Type:      "reveal_card",
-				SlotIndex: entry.SlotIndex,
-				Card:      entry.Card,
-				Delay:     0, // No delay for reconnect — show instantly
-			})
-		}
-
-		win := game.CheckWin()
-		boardCards := make([]BoardCard, 0, len(revealOrder))
-		for _, entry := range revealOrder {
-			boardCards = append(boardCards, BoardCard{SlotIndex: entry.SlotIndex, Card: entry.Card})
-		}
-
-		c.SendMsg(GameResultMsg{
-			Type:  "game_result",
-			Win:   win,
-			Board: boardCards,
-		})
-	}
+	c.SendMsg(c.room.gameStateLocked(c.playerNumber))
 }
 
 func (c *Client) handleTurnOrderPick(raw []byte) {
@@ -358,55 +358,60 @@ func (c *Client) handleTurnOrderPick(raw []byte) {
 	game.SetPick(c.playerNumber, Preference(msg.Preference))
 	slog.Info("turn order pick received", "player", c.name, "preference", msg.Preference, "room", c.room.Code)
 
-	if !game.BothPicked() {
+	if !game.AllPicked() {
 		c.room.mu.Unlock()
 		return
 	}
 
-	// Both picked — resolve
+	// Everyone picked — resolve
 	firstPlayer, conflict := game.ResolveTurnOrder()
 
+	picks := make([]string, len(game.Picks))
+	for i, p := range game.Picks {
+		picks[i] = string(p)
+	}
+
 	result := TurnOrderResultMsg{
 		Type:     "turn_order_result",
-		Pick1:    string(game.Picks[0]),
-		Pick2:    string(game.Picks[1]),
+		Pick1:    picks[0],
+		Pick2:    picks[1],
+		Picks:    picks,
 		Conflict: conflict,
 	}
 
 	if conflict {
 		game.ResetPicks()
-		p1 := c.room.Players[0]
-		p2 := c.room.Players[1]
+		audience := c.room.audienceLocked()
 		c.room.mu.Unlock()
 
-		broadcast(p1, p2, result)
+		broadcast(audience, result)
 		return
 	}
 
 	// Resolved — transition to placement phase
-	game.FirstPlayer = firstPlayer
-	game.CurrentTurn = firstPlayer
-	game.Phase = PhasePlacement
+	game.StartPlacement(firstPlayer)
 	result.FirstPlayer = firstPlayer
+	deadline := deadlineMillis(game.TurnDeadline())
 
-	hand1 := game.Hands[0][:]
-	hand2 := game.Hands[1][:]
-	p1 := c.room.Players[0]
-	p2 := c.room.Players[1]
+	players := c.room.playersLocked()
+	audience := c.room.audienceLocked()
 	c.room.mu.Unlock()
 
-	broadcast(p1, p2, result)
+	broadcast(audience, result)
 
 	// Send game_start with each player's hand
-	if p1 != nil {
-		p1.SendMsg(GameStartMsg{Type: "game_start", Hand: hand1, FirstPlayer: firstPlayer})
-	}
-
-	if p2 != nil {
-		p2.SendMsg(GameStartMsg{Type: "game_start", Hand: hand2, FirstPlayer: firstPlayer})
+	for i, p := range players {
+		if p != nil {
+			p.SendMsg(GameStartMsg{
+				Type:        "game_start",
+				Hand:        game.Hands[i][:],
+				FirstPlayer: firstPlayer,
+				SuitHint:    game.SuitHintFor(i + 1),
+			})
+		}
 	}
 
-	sendYourTurn(firstPlayer, p1, p2)
+	sendYourTurn(firstPlayer, players, deadline)
 }
 
 func (c *Client) handlePlaceCard(raw []byte) {
@@ -437,26 +442,26 @@ func (c *Client) handlePlaceCard(raw []byte) {
 
 	phase := game.Phase
 	currentTurn := game.CurrentTurn
-	var revealOrder []RevealEntry
-	var win bool
+	deadline := deadlineMillis(game.TurnDeadline())
+	var outcome gameOutcome
 	if phase == PhaseReveal {
-		revealOrder, win = game.FinalizeReveal()
+		outcome = finalizeOutcome(c.room)
 	}
 
-	p1 := c.room.Players[0]
-	p2 := c.room.Players[1]
+	players := c.room.playersLocked()
+	audience := c.room.audienceLocked()
 	c.room.mu.Unlock()
 
 	slog.Info("card placed", "player", c.name, "slot", msg.SlotIndex, "room", c.room.Code)
 
-	broadcast(p1, p2, CardPlacedMsg{Type: "card_placed", SlotIndex: msg.SlotIndex, ByPlayer: c.playerNumber})
+	broadcast(audience, CardPlacedMsg{Type: "card_placed", SlotIndex: msg.SlotIndex, ByPlayer: c.playerNumber})
 
 	if phase == PhaseSwap {
-		broadcast(p1, p2, SwapPromptMsg{Type: "swap_prompt", ByPlayer: currentTurn})
+		broadcast(audience, SwapPromptMsg{Type: "swap_prompt", ByPlayer: currentTurn, Deadline: deadline})
 	} else if phase == PhaseReveal {
-		sendRevealCards(p1, p2, revealOrder, win)
+		sendRevealCards(audience, outcome)
 	} else {
-		sendYourTurn(currentTurn, p1, p2)
+		sendYourTurn(currentTurn, players, deadline)
 	}
 }
 
@@ -481,15 +486,16 @@ func (c *Client) handlePass() {
 	}
 
 	currentTurn := game.CurrentTurn
-	p1 := c.room.Players[0]
-	p2 := c.room.Players[1]
+	deadline := deadlineMillis(game.TurnDeadline())
+	players := c.room.playersLocked()
+	audience := c.room.audienceLocked()
 	c.room.mu.Unlock()
 
 	slog.Info("player passed", "player", c.name, "room", c.room.Code)
 
-	broadcast(p1, p2, PlayerPassedMsg{Type: "player_passed", ByPlayer: c.playerNumber})
+	broadcast(audience, PlayerPassedMsg{Type: "player_passed", ByPlayer: c.playerNumber})
 
-	sendYourTurn(currentTurn, p1, p2)
+	sendYourTurn(currentTurn, players, deadline)
 }
 
 func (c *Client) handlePeek(raw []byte) {
@@ -551,13 +557,19 @@ func (c *Client) handleSuggestSwap(raw []byte) {
 
 	slotA := game.SwapSlots[0]
 	slotB := game.SwapSlots[1]
-	p1 := c.room.Players[0]
-	p2 := c.room.Players[1]
+	deadline := deadlineMillis(game.TurnDeadline())
+	audience := c.room.audienceLocked()
 	c.room.mu.Unlock()
 
 	slog.Info("swap suggested", "player", c.name, "slotA", slotA, "slotB", slotB, "room", c.room.Code)
 
-	broadcast(p1, p2, SwapSuggestedMsg{Type: "swap_suggested", SlotA: slotA, SlotB: slotB, ByPlayer: c.playerNumber})
+	broadcast(audience, SwapSuggestedMsg{
+		Type:     "swap_suggested",
+		SlotA:    slotA,
+		SlotB:    slotB,
+		ByPlayer: c.playerNumber,
+		Deadline: deadline,
+	})
 }
 
 func (c *Client) handleSkipSwap() {
@@ -582,24 +594,23 @@ func (c *Client) handleSkipSwap() {
 
 	phase := game.Phase
 	currentTurn := game.CurrentTurn
-	var revealOrder []RevealEntry
-	var win bool
+	deadline := deadlineMillis(game.TurnDeadline())
+	var outcome gameOutcome
 	if phase == PhaseReveal {
-		revealOrder, win = game.FinalizeReveal()
+		outcome = finalizeOutcome(c.room)
 	}
 
-	p1 := c.room.Players[0]
-	p2 := c.room.Players[1]
+	audience := c.room.audienceLocked()
 	c.room.mu.Unlock()
 
 	slog.Info("swap skipped", "player", c.name, "room", c.room.Code)
 
-	broadcast(p1, p2, SwapResultMsg{Type: "swap_result", Accepted: false})
+	broadcast(audience, SwapResultMsg{Type: "swap_result", Accepted: false})
 
 	if phase == PhaseSwap {
-		broadcast(p1, p2, SwapPromptMsg{Type: "swap_prompt", ByPlayer: currentTurn})
+		broadcast(audience, SwapPromptMsg{Type: "swap_prompt", ByPlayer: currentTurn, Deadline: deadline})
 	} else if phase == PhaseReveal {
-		sendRevealCards(p1, p2, revealOrder, win)
+		sendRevealCards(audience, outcome)
 	}
 }
 
@@ -628,6 +639,7 @@ func (c *Client) handleRespondSwap(raw []byte) {
 	suggester := game.SwapSuggester
 	phaseBefore := game.Phase
 	turnBefore := game.CurrentTurn
+	swapsBefore := game.SwapsCompleted
 
 	if err := game.RespondSwap(c.playerNumber, msg.Accept); err != nil {
 		c.room.mu.Unlock()
@@ -637,15 +649,16 @@ func (c *Client) handleRespondSwap(raw []byte) {
 
 	phase := game.Phase
 	currentTurn := game.CurrentTurn
-	phaseChanged := phase != phaseBefore || currentTurn != turnBefore
-	var revealOrder []RevealEntry
-	var win bool
+	// Auto-skipped turns can hand the swap turn back to the same player
+	phaseChanged := phase != phaseBefore || currentTurn != turnBefore || game.SwapsCompleted != swapsBefore
+	deadline := deadlineMillis(game.TurnDeadline())
+	var outcome gameOutcome
 	if phase == PhaseReveal && phaseChanged {
-		revealOrder, win = game.FinalizeReveal()
+		outcome = finalizeOutcome(c.room)
 	}
 
-	p1 := c.room.Players[0]
-	p2 := c.room.Players[1]
+	players := c.room.playersLocked()
+	audience := c.room.audienceLocked()
 	c.room.mu.Unlock()
 
 	slog.Info("swap response", "player", c.name, "accepted", msg.Accept, "room", c.room.Code)
@@ -658,15 +671,18 @@ func (c *Client) handleRespondSwap(raw []byte) {
 		ByPlayer: suggester,
 	}
 
-	broadcast(p1, p2, result)
+	broadcast(audience, result)
 
 	// Only send follow-up messages if the swap advanced the game state
 	if phaseChanged {
 		if phase == PhaseSwap {
-			broadcast(p1, p2, SwapPromptMsg{Type: "swap_prompt", ByPlayer: currentTurn})
+			broadcast(audience, SwapPromptMsg{Type: "swap_prompt", ByPlayer: currentTurn, Deadline: deadline})
 		} else if phase == PhaseReveal {
-			sendRevealCards(p1, p2, revealOrder, win)
+			sendRevealCards(audience, outcome)
 		}
+	} else if phase == PhasePlacement && deadline != 0 {
+		// The answer restarted the clock for the player placing
+		sendYourTurn(currentTurn, players, deadline)
 	}
 }
 
@@ -676,6 +692,11 @@ func (c *Client) handlePlayAgain() {
 		return
 	}
 
+	if c.rooms.Draining() {
+		c.SendMsg(newError("server is shutting down"))
+		return
+	}
+
 	c.room.mu.Lock()
 	game := c.room.Game
 	if game == nil || game.Phase != PhaseGameOver {
@@ -692,19 +713,22 @@ func (c *Client) handlePlayAgain() {
 	}
 
 	c.room.PlayAgainReady[idx] = true
-	bothReady := c.room.PlayAgainReady[0] && c.room.PlayAgainReady[1]
-	p1 := c.room.Players[0]
-	p2 := c.room.Players[1]
+	allReady := true
+	for _, ready := range c.room.PlayAgainReady {
+		allReady = allReady && ready
+	}
+
+	players := c.room.playersLocked()
 	c.room.mu.Unlock()
 
 	slog.Info("play again requested", "player", c.name, "room", c.room.Code)
 
-	if !bothReady {
-		broadcast(p1, p2, PlayAgainWaitingMsg{Type: "play_again_waiting", PlayerName: c.name})
+	if !allReady {
+		broadcast(players, PlayAgainWaitingMsg{Type: "play_again_waiting", PlayerName: c.name})
 		return
 	}
 
-	// Both ready — start new game
+	// Everyone ready — start new game
 	newGame, err := c.room.ResetGame()
 	if err != nil {
 		slog.Error("failed to reset game for rematch", "error", err, "room", c.room.Code)
@@ -713,14 +737,8 @@ func (c *Client) handlePlayAgain() {
 	}
 
 	slog.Info("rematch started", "room", c.room.Code, "phase", newGame.Phase)
-
-	if p1 != nil {
-		p1.SendMsg(TurnOrderPromptMsg{Type: "turn_order_prompt", Hand: newGame.Hands[0][:]})
-	}
-
-	if p2 != nil {
-		p2.SendMsg(TurnOrderPromptMsg{Type: "turn_order_prompt", Hand: newGame.Hands[1][:]})
-	}
+	sendTurnOrderPrompts(players, newGame)
+	c.room.sendSpectatorState()
 }
 
 func (c *Client) handleExitGame() {
@@ -730,16 +748,23 @@ func (c *Client) handleExitGame() {
 
 	room := c.room
 
-	// Notify partner that this player intentionally left
-	if partner := room.Partner(c); partner != nil {
-		partner.SendMsg(PartnerExitedMsg{
-			Type:       "partner_exited",
-			PlayerName: c.name,
-		})
+	if c.spectator {
+		room.RemoveSpectator(c)
+		slog.Info("spectator exited", "spectator", c.name, "room", room.Code)
+		c.room = nil
+		c.spectator = false
+		return
 	}
 
+	// Notify partners and spectators that this player intentionally left
+	broadcast(room.Others(c), PartnerExitedMsg{
+		Type:       "partner_exited",
+		PlayerName: c.name,
+	})
+
 	// Remove from room and reset game
 	room.ExitPlayer(c, c.rooms)
+	room.sendSpectatorState()
 
 	slog.Info("player exited", "player", c.name, "room", room.Code)
 
@@ -747,13 +772,6 @@ func (c *Client) handleExitGame() {
 	c.room = nil
 }
 
-// allowedEmotes defines the set of valid emote strings.
-var allowedEmotes = map[string]bool{
-	"Wow":         true,
-	"Well Played": true,
-	"Interesting": true,
-}
-
 func (c *Client) handleSendEmote(raw []byte) {
 	var msg SendEmoteMsg
 	if err := json.Unmarshal(raw, &msg); err != nil {
@@ -766,38 +784,115 @@ func (c *Client) handleSendEmote(raw []byte) {
 		return
 	}
 
-	if !allowedEmotes[msg.Emote] {
+	if !c.room.allowsEmote(msg.Emote) {
 		c.SendMsg(newError("invalid emote"))
 		return
 	}
 
-	partner := c.room.Partner(c)
-	if partner == nil {
+	if wait := emoteCooldown - time.Since(c.lastEmote); wait > 0 {
+		c.SendMsg(newError(fmt.Sprintf("wait %.0fs before sending another emote", math.Ceil(wait.Seconds()))))
 		return
 	}
 
-	partner.SendMsg(EmoteReceivedMsg{
-		Type:       "emote_received",
-		Emote:      msg.Emote,
-		FromPlayer: c.playerNumber,
-	})
+	c.lastEmote = time.Now()
+
+	c.room.mu.Lock()
+	if game := c.room.Game; game != nil {
+		game.CountEmote(c.playerNumber)
+	}
+	c.room.mu.Unlock()
+
+	for _, p := range c.room.Partners(c) {
+		p.SendMsg(EmoteReceivedMsg{
+			Type:       "emote_received",
+			Emote:      msg.Emote,
+			FromPlayer: c.playerNumber,
+		})
+	}
 }
 
 // sendYourTurn sends a your_turn message to the player whose turn it is.
-func sendYourTurn(currentTurn int, p1, p2 *Client) {
-	yourTurn := YourTurnMsg{Type: "your_turn"}
+func sendYourTurn(currentTurn int, players []*Outbox, deadline int64) {
+	if p := players[currentTurn-1]; p != nil {
+		p.SendMsg(YourTurnMsg{Type: "your_turn", Deadline: deadline})
+	}
+}
+
+// gameOutcome holds everything sent to players when a game ends.
+type gameOutcome struct {
+	order     []RevealEntry
+	win       bool
+	analysis  GameAnalysis
+	score     ScoreBreakdown
+	suitOrder []Suit
+	signals   []Signal
+	emotes    []int
+	daily     *DailyResultsMsg // only set in daily challenge rooms
+	gameID    string
+}
+
+// finalizeOutcome moves the room's game to PhaseGameOver and computes its
+// outcome, recording it if the room plays the daily challenge.
+// The caller must hold the room lock.
+func finalizeOutcome(room *Room) gameOutcome {
+	room.Game.FinalizeReveal()
+	room.archiveLocked()
+	outcome := outcomeOf(room.Game)
+	countFinished(outcome.win)
+
+	if room.daily != nil && room.Game.Daily {
+		date := DailyDate(room.Created)
+		results := room.daily.Record(date, DailyResult{
+			Players:  room.namesLocked(),
+			Win:      outcome.win,
+			Score:    outcome.score.Total,
+			Finished: time.Now(),
+		})
 
-	if currentTurn == 1 && p1 != nil {
-		p1.SendMsg(yourTurn)
-	} else if currentTurn == 2 && p2 != nil {
-		p2.SendMsg(yourTurn)
+		outcome.daily = &DailyResultsMsg{Type: "daily_results", Date: date, Results: results}
 	}
+
+	return outcome
 }
 
-// sendRevealCards sends reveal_card messages to both players with staggered delays,
-// followed by the game_result message.
-func sendRevealCards(p1, p2 *Client, order []RevealEntry, win bool) {
-	for i, entry := range order {
+// outcomeOf computes the outcome of a finished game.
+// The caller must hold the room lock.
+func outcomeOf(game *Game) gameOutcome {
+	return gameOutcome{
+		order:     game.RevealOrder(),
+		win:       game.CheckWin(),
+		analysis:  game.Analyze(),
+		score:     game.Score(),
+		suitOrder: game.Deck.Suits,
+		signals:   append([]Signal(nil), game.Signals...),
+		emotes:    append([]int(nil), game.EmotesSent...),
+		gameID:    game.ID,
+	}
+}
+
+// resultMsg builds the game_result message for the outcome.
+func (o gameOutcome) resultMsg() GameResultMsg {
+	boardCards := make([]BoardCard, 0, len(o.order))
+	for _, entry := range o.order {
+		boardCards = append(boardCards, BoardCard{SlotIndex: entry.SlotIndex, Card: entry.Card})
+	}
+
+	return GameResultMsg{
+		Type:      "game_result",
+		Win:       o.win,
+		Board:     boardCards,
+		Score:     o.score,
+		SuitOrder: o.suitOrder,
+		Signals:   o.signals,
+		Emotes:    o.emotes,
+		GameID:    o.gameID,
+	}
+}
+
+// sendRevealCards sends reveal_card messages to all players with staggered delays,
+// followed by the game_result message and, on a loss, the game_analysis message.
+func sendRevealCards(players []*Outbox, outcome gameOutcome) {
+	for i, entry := range outcome.order {
 		msg := RevealCardMsg{
 			Type:      "reveal_card",
 			SlotIndex: entry.SlotIndex,
@@ -805,17 +900,16 @@ func sendRevealCards(p1, p2 *Client, order []RevealEntry, win bool) {
 			Delay:     i * delayPerCard,
 		}
 
-		broadcast(p1, p2, msg)
+		broadcast(players, msg)
 	}
 
-	boardCards := make([]BoardCard, 0, len(order))
-	for _, entry := range order {
-		boardCards = append(boardCards, BoardCard{SlotIndex: entry.SlotIndex, Card: entry.Card})
+	broadcast(players, outcome.resultMsg())
+
+	if !outcome.win {
+		broadcast(players, GameAnalysisMsg{Type: "game_analysis", GameAnalysis: outcome.analysis})
 	}
 
-	broadcast(p1, p2, GameResultMsg{
-		Type:  "game_result",
-		Win:   win,
-		Board: boardCards,
-	})
+	if outcome.daily != nil {
+		broadcast(players, *outcome.daily)
+	}
 }
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestRespondSwapPromptsRepeatTurn(t *testing.T) {
+	rules := DefaultRules()
+	rules.SwapRounds = 2
+	rules.SwapsPerPlayer = 2
+
+	rm := NewRoomManager()
+	room, err := rm.CreateRoom(rules)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	alice, bob := newTestClient(rm), newTestClient(rm)
+	sendTestMsg(t, alice, JoinRoomMsg{Type: "join_room", Name: "Alice", RoomCode: room.Code})
+	sendTestMsg(t, bob, JoinRoomMsg{Type: "join_room", Name: "Bob", RoomCode: room.Code})
+	sendTestMsg(t, alice, TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(PrefFirst)})
+	sendTestMsg(t, bob, TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(PrefNoFirst)})
+	for i := range 7 {
+		sendTestMsg(t, alice, PlaceCardMsg{Type: "place_card", CardIndex: i, SlotIndex: i})
+		sendTestMsg(t, bob, PlaceCardMsg{Type: "place_card", CardIndex: i, SlotIndex: 7 + i})
+	}
+
+	// Bob has no swaps left, so Bob's swap turns are skipped
+	room.mu.Lock()
+	if room.Game.Phase != PhaseSwap || room.Game.CurrentTurn != 1 {
+		room.mu.Unlock()
+		t.Fatalf("expected Alice's swap turn, got phase %s turn %d", room.Game.Phase, room.Game.CurrentTurn)
+	}
+	room.Game.SwapsAccepted[1] = rules.SwapsPerPlayer
+	room.mu.Unlock()
+
+	sendTestMsg(t, alice, SuggestSwapMsg{Type: "suggest_swap", SlotA: 0, SlotB: 1})
+	drainTypes(t, alice)
+	sendTestMsg(t, bob, RespondSwapMsg{Type: "respond_swap", Accept: true})
+
+	var prompt SwapPromptMsg
+	for {
+		select {
+		case raw := <-alice.send:
+			if err := json.Unmarshal(raw, &prompt); err != nil {
+				t.Fatalf("invalid message: %v", err)
+			}
+
+			if prompt.Type != "swap_prompt" {
+				continue
+			}
+
+			if prompt.ByPlayer != 1 {
+				t.Errorf("expected Alice to swap again, got player %d", prompt.ByPlayer)
+			}
+
+			return
+		default:
+			t.Fatal("expected a swap_prompt for Alice's second swap turn")
+		}
+	}
+}
@@ -38,7 +38,13 @@ func handleWebSocket(rooms *RoomManager) http.HandlerFunc {
 			return
 		}
 
+		activeConnections.Add(1)
+		defer activeConnections.Add(-1)
+
 		client := NewClient(conn, rooms)
+		rooms.Track(client)
+		defer rooms.Untrack(client)
+
 		go client.WritePump()
 		client.ReadPump()
 	}
@@ -80,8 +86,24 @@ func spaHandler(dir string) http.Handler {
 
 func main() {
 	staticDir := flag.String("static", "", "directory to serve static files from (Angular dist)")
+	tournament := flag.Bool("tournament", false, "run a headless bot tournament instead of the server")
+	games := flag.Int("games", 1000, "games per strategy pairing in tournament mode")
+	strategyList := flag.String("strategies", strings.Join(StrategyNames(), ","), "comma-separated strategies for tournament mode")
+	dataDir := flag.String("data", "", "directory to persist rooms in across restarts (persistence is off if empty)")
+	emoteFile := flag.String("emotes", "", "JSON file of emote packs rooms can choose from, added to the built-in ones")
+	drain := flag.Duration("drain", 60*time.Second, "how long games in progress may keep playing once shutdown starts")
+	downtime := flag.Duration("downtime", 0, "expected downtime to announce on shutdown (0 if unknown)")
 	flag.Parse()
 
+	if *tournament {
+		if err := RunTournament(os.Stdout, strings.Split(*strategyList, ","), *games); err != nil {
+			slog.Error("tournament failed", "error", err)
+			os.Exit(1)
+		}
+
+		return
+	}
+
 	// Port from env or default
 	port := os.Getenv("PORT")
 	if port == "" {
@@ -104,10 +126,39 @@ func main() {
 	}
 
 	rooms := NewRoomManager()
+	if *emoteFile != "" {
+		packs, err := LoadEmotePacks(*emoteFile)
+		if err != nil {
+			slog.Error("failed to load emote packs", "error", err)
+			os.Exit(1)
+		}
+
+		rooms.UseEmotePacks(packs)
+		slog.Info("emote packs loaded", "packs", len(packs))
+	}
+
+	if *dataDir != "" {
+		store, err := NewRoomStore(*dataDir)
+		if err != nil {
+			slog.Error("failed to open data directory", "error", err)
+			os.Exit(1)
+		}
+
+		if err := rooms.Restore(store); err != nil {
+			slog.Error("failed to restore rooms", "error", err)
+			os.Exit(1)
+		}
+
+		slog.Info("persisting rooms", "dir", *dataDir)
+	}
+
 	rooms.StartEmptyRoomCleanup(5 * time.Minute)
 
 	mux := http.NewServeMux()
 	mux.HandleFunc("/ws", handleWebSocket(rooms))
+	mux.HandleFunc("/api/daily", handleDailyResults(rooms))
+	mux.HandleFunc("GET /api/replays/{id}", handleReplay(rooms))
+	mux.HandleFunc("GET /metrics", handleMetrics(rooms))
 
 	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
@@ -125,6 +176,29 @@ func main() {
 		Handler: mux,
 	}
 
+	// The admin API listens separately so it can stay off the public network
+	var admin *http.Server
+	if addr := os.Getenv("ADMIN_ADDR"); addr != "" {
+		token := os.Getenv("ADMIN_TOKEN")
+		if token == "" {
+			slog.Error("ADMIN_ADDR is set but ADMIN_TOKEN is empty")
+			os.Exit(1)
+		}
+
+		admin = &http.Server{
+			Addr:    addr,
+			Handler: adminHandler(rooms, token),
+		}
+
+		go func() {
+			slog.Info("admin API starting", "addr", admin.Addr)
+			if err := admin.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				slog.Error("admin server error", "error", err)
+				os.Exit(1)
+			}
+		}()
+	}
+
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
 
@@ -137,12 +211,44 @@ func main() {
 	}()
 
 	<-stop
-	slog.Info("shutting down")
+	slog.Info("shutting down", "drain", *drain, "downtime", *downtime)
+
+	// Clients told to expect the server back know to reconnect later
+	code, reason := websocket.CloseGoingAway, "server shutting down"
+	if *downtime > 0 {
+		code, reason = websocket.CloseServiceRestart, "server restarting"
+	}
+
+	rooms.Drain(ServerShutdownMsg{
+		Type:            "server_shutdown",
+		Reason:          reason,
+		Deadline:        time.Now().Add(*drain).UnixMilli(),
+		DowntimeSeconds: int(downtime.Seconds()),
+	})
+
+	drainCtx, cancelDrain := context.WithTimeout(context.Background(), *drain)
+	if !rooms.WaitForGames(drainCtx) {
+		slog.Warn("drain window over with games in progress", "games", rooms.ActiveGames())
+	}
+	cancelDrain()
 
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 
-	if err := server.Shutdown(ctx); err != nil {
+	rooms.CloseConnections(code, reason)
+	if !rooms.WaitForDisconnects(ctx) {
+		slog.Warn("connections still open after close")
+	}
+
+	if admin != nil {
+		if err := admin.Shutdown(ctx); err != nil {
+			slog.Warn("admin shutdown error", "error", err)
+		}
+	}
+
+	err := server.Shutdown(ctx)
+	rooms.SaveAll()
+	if err != nil {
 		slog.Error("shutdown error", "error", err)
 		os.Exit(1)
 	}
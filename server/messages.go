@@ -8,33 +8,109 @@ type Envelope struct {
 // --- Client → Server ---
 
 // CreateRoomMsg requests creation of a new game room.
+// If Bot is set, server-side bots fill the remaining seats immediately,
+// played by BotStrategy (or the default strategy if empty).
+// Rule fields left out of Rules keep their default values. A daily challenge
+// room (Rules.Daily) always plays by DailyRules.
 type CreateRoomMsg struct {
-	Type string `json:"type"`
-	Name string `json:"name"`
+	Type        string `json:"type"`
+	Name        string `json:"name"`
+	Bot         bool   `json:"bot,omitempty"`
+	BotStrategy string `json:"botStrategy,omitempty"`
+	Rules       Rules  `json:"rules"`
 }
 
-// JoinRoomMsg requests joining an existing room.
+// JoinRoomMsg requests joining an existing room. With Spectate set the
+// client watches the room without taking a seat.
 type JoinRoomMsg struct {
 	Type     string `json:"type"`
 	Name     string `json:"name"`
 	RoomCode string `json:"roomCode"`
+	Spectate bool   `json:"spectate,omitempty"`
 }
 
 // --- Server → Client ---
-
-// RoomCreatedMsg is sent to the player who created a room.
+//
+// Every server message also carries a "seq" field, added when it is sent.
+// Sequence numbers count up per seat, including messages sent while the
+// player was disconnected, so a reconnecting client can resume from the last
+// one it saw. A game_state message replaces everything before it.
+// Connection-level notices (maintenance_notice, server_shutdown) are the
+// exception: they carry no seq and are never resent.
+
+// RoomCreatedMsg is sent to the player who created a room. Token is the
+// secret session token needed to reconnect to the seat.
 type RoomCreatedMsg struct {
 	Type         string `json:"type"`
 	RoomCode     string `json:"roomCode"`
 	PlayerNumber int    `json:"playerNumber"`
+	Token        string `json:"token"`
 }
 
-// PlayerJoinedMsg is sent to both players when the second player joins.
+// PlayerJoinedMsg is sent to every seated player when a player joins.
+// Rules shows the joining player what the room creator chose, including the
+// deck definition clients render cards from, and Emotes lists the emotes of
+// the room's emote pack. Players lists the names by seat ("" for an empty
+// seat); PartnerName is the first other seated player, kept for two-player
+// clients. Token is the recipient's own session token, which
+// changes on every reconnect.
 type PlayerJoinedMsg struct {
+	Type         string   `json:"type"`
+	PlayerName   string   `json:"playerName"`
+	PlayerNumber int      `json:"playerNumber"`
+	PartnerName  string   `json:"partnerName"`
+	Players      []string `json:"players"`
+	Rules        Rules    `json:"rules"`
+	Emotes       []string `json:"emotes"`
+	Token        string   `json:"token"`
+}
+
+// PublicGameState is the part of a room's state that every player and
+// spectator may see. BoardOwner holds the player who placed each slot
+// (0 for empty). Deadline is when the turn timer next acts, as in
+// YourTurnMsg. Chat is the room's recent chat, oldest first. Result and, on
+// a loss, Analysis are only set once the game is over.
+type PublicGameState struct {
+	Players        []string          `json:"players"`
+	Rules          Rules             `json:"rules"`
+	Phase          Phase             `json:"phase"`
+	FirstPlayer    int               `json:"firstPlayer,omitempty"`
+	CurrentTurn    int               `json:"currentTurn,omitempty"`
+	BoardOwner     []int             `json:"boardOwner,omitempty"`
+	PassesUsed     []int             `json:"passesUsed,omitempty"`
+	SwapsAccepted  []int             `json:"swapsAccepted,omitempty"`
+	SwapHistory    []SwapRecord      `json:"swapHistory,omitempty"`
+	Signals        []Signal          `json:"signals,omitempty"`
+	SignalsUsed    []int             `json:"signalsUsed,omitempty"`
+	SwapPending    *SwapSuggestedMsg `json:"swapPending,omitempty"`
+	UndoPending    *UndoRequestedMsg `json:"undoPending,omitempty"`
+	PlayAgainReady []bool            `json:"playAgainReady,omitempty"`
+	Chat           []ChatEntry       `json:"chat,omitempty"`
+	Deadline       int64             `json:"deadline,omitempty"`
+	Result         *GameResultMsg    `json:"result,omitempty"`
+	Analysis       *GameAnalysis     `json:"analysis,omitempty"`
+}
+
+// SpectatorStateMsg is sent to a spectator when they join, whenever the
+// seats or the game change, and on request_state.
+type SpectatorStateMsg struct {
+	Type string `json:"type"`
+	PublicGameState
+}
+
+// GameStateMsg is a player's complete view of the room: the public state
+// plus their own hand. It is sent on reconnect and on request_state, and
+// replaces everything the client knew before. Pick is the player's own turn
+// order pick while one is outstanding.
+type GameStateMsg struct {
 	Type         string `json:"type"`
 	PlayerName   string `json:"playerName"`
 	PlayerNumber int    `json:"playerNumber"`
-	PartnerName string `json:"partnerName"`
+	PublicGameState
+	Hand     []Card     `json:"hand,omitempty"`
+	HandUsed []bool     `json:"handUsed,omitempty"`
+	SuitHint *SuitHint  `json:"suitHint,omitempty"`
+	Pick     Preference `json:"pick,omitempty"`
 }
 
 // PlayerDisconnectedMsg is sent to the remaining player when the other disconnects.
@@ -49,17 +125,31 @@ type PlayerReconnectedMsg struct {
 	PlayerName string `json:"playerName"`
 }
 
-// ReconnectMsg is sent by a reconnecting client to rejoin a room.
+// RequestStateMsg asks the server to resend the game_state (or, for
+// spectators, spectator_state) so a confused client can resync.
+type RequestStateMsg struct {
+	Type string `json:"type"`
+}
+
+// ReconnectMsg is sent by a reconnecting client to rejoin a room. Token must
+// be the latest session token the server sent for the seat. LastSeq is the
+// last seq the client received; the server resends everything after it, or
+// sends a full game_state if it is 0 or too far behind.
 type ReconnectMsg struct {
 	Type     string `json:"type"`
 	Name     string `json:"name"`
 	RoomCode string `json:"roomCode"`
+	Token    string `json:"token"`
+	LastSeq  uint64 `json:"lastSeq,omitempty"`
 }
 
 // ErrorResponseMsg is sent to a client when an error occurs.
 type ErrorResponseMsg struct {
-	Type    string `json:"type"`
-	Message string `json:"message"`
+	Type       string `json:"type"`
+	Message    string `json:"message"`
+	Code       string `json:"code,omitempty"`         // machine-readable reason, e.g. "rate_limited"
+	Of         string `json:"of,omitempty"`           // the message type the error is about
+	RetryAfter int64  `json:"retryAfterMs,omitempty"` // when the message may be sent again
 }
 
 // newError creates a new error response message.
@@ -70,10 +160,12 @@ func newError(message string) ErrorResponseMsg {
 // --- Game messages ---
 
 // TurnOrderPromptMsg asks a player to pick their turn order preference.
-// Includes the player's hand so they can make a strategic decision.
+// Includes the player's hand so they can make a strategic decision, and the
+// suit pair they were told when playing with a partially secret suit order.
 type TurnOrderPromptMsg struct {
-	Type string `json:"type"`
-	Hand []Card `json:"hand"`
+	Type     string    `json:"type"`
+	Hand     []Card    `json:"hand"`
+	SuitHint *SuitHint `json:"suitHint,omitempty"`
 }
 
 // TurnOrderPickMsg is sent by a player to indicate their turn order preference.
@@ -82,26 +174,32 @@ type TurnOrderPickMsg struct {
 	Preference string `json:"preference"`
 }
 
-// TurnOrderResultMsg is sent to both players after both have picked.
+// TurnOrderResultMsg is sent to every player after all have picked.
+// Picks holds every pick by seat; Pick1 and Pick2 are kept for
+// two-player clients.
 type TurnOrderResultMsg struct {
-	Type        string `json:"type"`
-	Pick1       string `json:"pick1"`
-	Pick2       string `json:"pick2"`
-	Conflict    bool   `json:"conflict"`
-	FirstPlayer int    `json:"firstPlayer,omitempty"`
+	Type        string   `json:"type"`
+	Pick1       string   `json:"pick1"`
+	Pick2       string   `json:"pick2"`
+	Picks       []string `json:"picks"`
+	Conflict    bool     `json:"conflict"`
+	FirstPlayer int      `json:"firstPlayer,omitempty"`
 }
 
 // YourTurnMsg is sent to the active player to prompt them for their turn.
+// Deadline is when the turn timer runs out, in Unix milliseconds; it is left
+// out when the room has no turn timer.
 type YourTurnMsg struct {
-	Type string `json:"type"`
+	Type     string `json:"type"`
+	Deadline int64  `json:"deadline,omitempty"`
 }
 
 // GameStartMsg is sent to each player when the game begins, containing their hand.
 type GameStartMsg struct {
-	Type        string `json:"type"`
-	Hand        []Card `json:"hand"`
-	FirstPlayer int    `json:"firstPlayer"`
-	HandUsed    []bool `json:"handUsed,omitempty"` // only set during reconnection
+	Type        string    `json:"type"`
+	Hand        []Card    `json:"hand"`
+	FirstPlayer int       `json:"firstPlayer"`
+	SuitHint    *SuitHint `json:"suitHint,omitempty"`
 }
 
 // --- Placement phase messages ---
@@ -124,14 +222,14 @@ type PeekMsg struct {
 	SlotIndex int    `json:"slotIndex"`
 }
 
-// CardPlacedMsg notifies both players that a card was placed (face-down).
+// CardPlacedMsg notifies all players that a card was placed (face-down).
 type CardPlacedMsg struct {
 	Type      string `json:"type"`
 	SlotIndex int    `json:"slotIndex"`
 	ByPlayer  int    `json:"byPlayer"`
 }
 
-// PlayerPassedMsg notifies both players that a player used their pass.
+// PlayerPassedMsg notifies all players that a player used their pass.
 type PlayerPassedMsg struct {
 	Type     string `json:"type"`
 	ByPlayer int    `json:"byPlayer"`
@@ -145,9 +243,11 @@ type PeekResultMsg struct {
 }
 
 // SwapPromptMsg notifies a player that it is their turn to suggest a swap.
+// Deadline is as in YourTurnMsg.
 type SwapPromptMsg struct {
 	Type     string `json:"type"`
 	ByPlayer int    `json:"byPlayer"`
+	Deadline int64  `json:"deadline,omitempty"`
 }
 
 // --- Swap phase messages (Client → Server) ---
@@ -172,15 +272,16 @@ type RespondSwapMsg struct {
 
 // --- Swap phase messages (Server → Client) ---
 
-// SwapSuggestedMsg notifies both players that a swap has been suggested.
+// SwapSuggestedMsg notifies all players that a swap has been suggested.
 type SwapSuggestedMsg struct {
 	Type     string `json:"type"`
 	SlotA    int    `json:"slotA"`
 	SlotB    int    `json:"slotB"`
 	ByPlayer int    `json:"byPlayer"`
+	Deadline int64  `json:"deadline,omitempty"` // when the suggestion is rejected unanswered
 }
 
-// SwapResultMsg notifies both players of the swap outcome.
+// SwapResultMsg notifies all players of the swap outcome.
 type SwapResultMsg struct {
 	Type     string `json:"type"`
 	Accepted bool   `json:"accepted"`
@@ -189,9 +290,59 @@ type SwapResultMsg struct {
 	ByPlayer int    `json:"byPlayer"`
 }
 
+// --- Undo messages ---
+
+// UndoRequestMsg is sent by a player to ask to take back the card they just
+// placed. The request lapses if anyone acts before it is answered.
+type UndoRequestMsg struct {
+	Type string `json:"type"`
+}
+
+// RespondUndoMsg is sent by a player to accept or reject an undo request.
+type RespondUndoMsg struct {
+	Type   string `json:"type"`
+	Accept bool   `json:"accept"`
+}
+
+// UndoRequestedMsg notifies all players that a player asked to take back the
+// card at SlotIndex. Deadline is when the request is rejected unanswered.
+type UndoRequestedMsg struct {
+	Type      string `json:"type"`
+	SlotIndex int    `json:"slotIndex"`
+	ByPlayer  int    `json:"byPlayer"`
+	Deadline  int64  `json:"deadline,omitempty"`
+}
+
+// UndoResultMsg notifies all players of the answer to an undo request. If
+// accepted, the card at SlotIndex leaves the board and it is ByPlayer's turn
+// again; their own copy carries their updated HandUsed.
+type UndoResultMsg struct {
+	Type      string `json:"type"`
+	Accepted  bool   `json:"accepted"`
+	SlotIndex int    `json:"slotIndex"`
+	ByPlayer  int    `json:"byPlayer"`
+	HandUsed  []bool `json:"handUsed,omitempty"`
+}
+
+// --- Signal messages ---
+
+// SendSignalMsg is sent by a player to spend a signal token on a hint about
+// their card at SlotIndex. Kind is one of the Signal kinds.
+type SendSignalMsg struct {
+	Type      string `json:"type"`
+	SlotIndex int    `json:"slotIndex"`
+	Kind      string `json:"kind"`
+}
+
+// SignalSentMsg notifies all players of a new signal on the board.
+type SignalSentMsg struct {
+	Type string `json:"type"`
+	Signal
+}
+
 // --- Reveal phase messages (Server → Client) ---
 
-// RevealCardMsg notifies both players of a card being revealed.
+// RevealCardMsg notifies all players of a card being revealed.
 type RevealCardMsg struct {
 	Type      string `json:"type"`
 	SlotIndex int    `json:"slotIndex"`
@@ -205,11 +356,36 @@ type BoardCard struct {
 	Card      Card `json:"card"`
 }
 
-// GameResultMsg notifies both players of the final game result.
+// GameResultMsg notifies all players of the final game result.
+// Win is kept for older clients; Score carries the graded result.
+// SuitOrder is the order the game was judged by, which reveals a secret one.
+// Signals are the hints players left on their cards and Emotes how many
+// emotes each player sent during the game.
+// GameID names the game's replay at /api/replays/{id}.
 type GameResultMsg struct {
-	Type  string      `json:"type"`
-	Win   bool        `json:"win"`
-	Board []BoardCard `json:"board"`
+	Type      string         `json:"type"`
+	Win       bool           `json:"win"`
+	Board     []BoardCard    `json:"board"`
+	Score     ScoreBreakdown `json:"score"`
+	SuitOrder []Suit         `json:"suitOrder"`
+	Signals   []Signal       `json:"signals,omitempty"`
+	Emotes    []int          `json:"emotes,omitempty"`
+	GameID    string         `json:"gameId"`
+}
+
+// DailyResultsMsg follows the game_result of a daily challenge game with
+// every result for that day's deal so far, best first.
+type DailyResultsMsg struct {
+	Type    string        `json:"type"`
+	Date    string        `json:"date"`
+	Results []DailyResult `json:"results"`
+}
+
+// GameAnalysisMsg follows a losing game_result and explains where the
+// ordering broke.
+type GameAnalysisMsg struct {
+	Type string `json:"type"`
+	GameAnalysis
 }
 
 // --- Emote messages ---
@@ -227,6 +403,49 @@ type EmoteReceivedMsg struct {
 	FromPlayer int    `json:"fromPlayer"`
 }
 
+// --- Chat messages ---
+
+// SendChatMsg is sent by a player to chat with the room. Chat is only allowed
+// in the lobby and once the game is over, never during play.
+type SendChatMsg struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// ChatReceivedMsg is sent to everyone in the room, including the sender,
+// when a player chats.
+type ChatReceivedMsg struct {
+	Type string `json:"type"`
+	ChatEntry
+}
+
+// --- Server notices ---
+
+// RoomClosedMsg tells everyone in a room that the server operator closed it.
+// The room is gone; clients return to the home screen.
+type RoomClosedMsg struct {
+	Type   string `json:"type"`
+	Reason string `json:"reason"`
+}
+
+// MaintenanceNoticeMsg is an operator's message to every connected client,
+// such as a warning of upcoming maintenance.
+type MaintenanceNoticeMsg struct {
+	Type    string `json:"type"`
+	Message string `json:"message"`
+}
+
+// ServerShutdownMsg tells every client that the server is shutting down.
+// Games in progress may keep playing until Deadline (Unix milliseconds);
+// every connection is closed then at the latest. DowntimeSeconds is how long the server is
+// expected to be down, if known.
+type ServerShutdownMsg struct {
+	Type            string `json:"type"`
+	Reason          string `json:"reason"`
+	Deadline        int64  `json:"deadline"`
+	DowntimeSeconds int    `json:"downtimeSeconds,omitempty"`
+}
+
 // --- Exit game messages ---
 
 // ExitGameMsg is sent by a player to intentionally leave the game.
@@ -247,7 +466,7 @@ type PlayAgainMsg struct {
 	Type string `json:"type"`
 }
 
-// PlayAgainWaitingMsg notifies both players that one player wants a rematch.
+// PlayAgainWaitingMsg notifies all players that one player wants a rematch.
 type PlayAgainWaitingMsg struct {
 	Type       string `json:"type"`
 	PlayerName string `json:"playerName"`
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sync/atomic"
+)
+
+// Operational counters for /metrics. droppedSends and rateLimited are
+// counted where they happen.
+var (
+	activeConnections atomic.Int64
+	gamesStarted      atomic.Uint64
+	gamesFinished     atomic.Uint64
+	gamesWon          atomic.Uint64
+	errorsSent        atomic.Uint64
+	graceExpirations  atomic.Uint64
+	reconnects        atomic.Uint64
+)
+
+// clientMessageTypes are the message types clients may send. Anything else
+// is counted as "unknown" so clients cannot create unbounded label values.
+var clientMessageTypes = []string{
+	"create_room", "join_room", "reconnect", "request_state",
+	"turn_order_pick", "place_card", "pass", "peek",
+	"suggest_swap", "skip_swap", "respond_swap", "undo_request", "respond_undo",
+	"send_signal", "play_again", "exit_game", "send_emote", "send_chat", "echo",
+}
+
+// messagesReceived counts received messages by type, plus "unknown".
+var messagesReceived = func() map[string]*atomic.Uint64 {
+	counts := map[string]*atomic.Uint64{unknownMessageType: new(atomic.Uint64)}
+	for _, typ := range clientMessageTypes {
+		counts[typ] = new(atomic.Uint64)
+	}
+
+	return counts
+}()
+
+// allPhases lists every phase a room can be in, for the rooms gauge.
+var allPhases = []Phase{PhaseLobby, PhaseTurnOrderPick, PhasePlacement, PhaseSwap, PhaseReveal, PhaseGameOver}
+
+// unknownMessageType stands in for any message type clients may not send,
+// and for frames that are not valid messages at all.
+const unknownMessageType = "unknown"
+
+// knownMessageType returns typ if clients may send it, else
+// unknownMessageType.
+func knownMessageType(typ string) string {
+	if _, ok := messagesReceived[typ]; !ok {
+		return unknownMessageType
+	}
+
+	return typ
+}
+
+// countReceived counts a received message of type typ.
+func countReceived(typ string) {
+	messagesReceived[knownMessageType(typ)].Add(1)
+}
+
+// countFinished counts a finished game.
+func countFinished(win bool) {
+	gamesFinished.Add(1)
+	if win {
+		gamesWon.Add(1)
+	}
+}
+
+// RoomsByPhase counts the rooms in each game phase.
+func (rm *RoomManager) RoomsByPhase() map[Phase]int {
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+
+	counts := make(map[Phase]int)
+	for _, room := range rm.rooms {
+		counts[room.GamePhase()]++
+	}
+
+	return counts
+}
+
+// handleMetrics serves the server's metrics in the Prometheus text format.
+func handleMetrics(rooms *RoomManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+		writeMetrics(w, rooms)
+	}
+}
+
+// writeMetrics writes every metric in the Prometheus text format.
+func writeMetrics(w io.Writer, rooms *RoomManager) {
+	metric := func(name, kind, help string) {
+		fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, kind)
+	}
+
+	metric("cards_connections", "gauge", "Active WebSocket connections.")
+	fmt.Fprintf(w, "cards_connections %d\n", activeConnections.Load())
+
+	metric("cards_rooms", "gauge", "Rooms by game phase.")
+	byPhase := rooms.RoomsByPhase()
+	for _, phase := range allPhases {
+		fmt.Fprintf(w, "cards_rooms{phase=%q} %d\n", phase, byPhase[phase])
+	}
+
+	metric("cards_games_started_total", "counter", "Games dealt.")
+	fmt.Fprintf(w, "cards_games_started_total %d\n", gamesStarted.Load())
+
+	finished, won := gamesFinished.Load(), gamesWon.Load()
+	metric("cards_games_finished_total", "counter", "Games played to the end.")
+	fmt.Fprintf(w, "cards_games_finished_total %d\n", finished)
+
+	metric("cards_games_won_total", "counter", "Finished games that were won.")
+	fmt.Fprintf(w, "cards_games_won_total %d\n", won)
+
+	metric("cards_win_rate", "gauge", "Share of finished games that were won.")
+	rate := 0.0
+	if finished > 0 {
+		rate = float64(won) / float64(finished)
+	}
+	fmt.Fprintf(w, "cards_win_rate %g\n", rate)
+
+	metric("cards_messages_received_total", "counter", "Client messages received by type.")
+	for _, typ := range append(clientMessageTypes, "unknown") {
+		fmt.Fprintf(w, "cards_messages_received_total{type=%q} %d\n", typ, messagesReceived[typ].Load())
+	}
+
+	metric("cards_errors_sent_total", "counter", "Error messages sent to clients.")
+	fmt.Fprintf(w, "cards_errors_sent_total %d\n", errorsSent.Load())
+
+	metric("cards_rate_limited_total", "counter", "Client messages rejected by rate limits.")
+	fmt.Fprintf(w, "cards_rate_limited_total %d\n", rateLimited.Load())
+
+	metric("cards_dropped_sends_total", "counter", "Messages dropped because a client's send buffer was full.")
+	fmt.Fprintf(w, "cards_dropped_sends_total %d\n", droppedSends.Load())
+
+	metric("cards_grace_expirations_total", "counter", "Disconnected players whose grace period ran out.")
+	fmt.Fprintf(w, "cards_grace_expirations_total %d\n", graceExpirations.Load())
+
+	metric("cards_reconnects_total", "counter", "Players who reconnected to their seat.")
+	fmt.Fprintf(w, "cards_reconnects_total %d\n", reconnects.Load())
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestMetrics(t *testing.T) {
+	rm := NewRoomManager()
+	if _, err := rm.CreateRoom(DefaultRules()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	before := messagesReceived["unknown"].Load()
+	alice := newTestClient(rm)
+	sendTestMsg(t, alice, Envelope{Type: "no_such_message"})
+	sendTestMsg(t, alice, Envelope{Type: "echo"})
+
+	if got := messagesReceived["unknown"].Load() - before; got != 1 {
+		t.Errorf("expected 1 unknown message counted, got %d", got)
+	}
+
+	rec := httptest.NewRecorder()
+	handleMetrics(rm)(rec, httptest.NewRequest("GET", "/metrics", nil))
+
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Errorf("unexpected content type %q", ct)
+	}
+
+	body := rec.Body.String()
+	for _, want := range []string{
+		"cards_rooms{phase=\"lobby\"} 1\n",
+		"cards_rooms{phase=\"placement\"} 0\n",
+		"# TYPE cards_games_started_total counter\n",
+		"cards_messages_received_total{type=\"echo\"} ",
+		"cards_reconnects_total ",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("expected %q in metrics:\n%s", want, body)
+		}
+	}
+
+	sample := regexp.MustCompile(`^[a-z_]+(\{[a-z]+="[a-z_]+"\})? [0-9.e+-]+$`)
+	for _, line := range strings.Split(strings.TrimSpace(body), "\n") {
+		if !strings.HasPrefix(line, "# ") && !sample.MatchString(line) {
+			t.Errorf("malformed metrics line %q", line)
+		}
+	}
+}
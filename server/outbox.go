@@ -0,0 +1,129 @@
+package main
+
+import (
+	"encoding/json"
+	"log/slog"
+	"strconv"
+	"sync"
+)
+
+// maxOutbox is how many recent messages a seat keeps for resuming. A client
+// that missed more than this gets a full resync instead.
+const maxOutbox = 128
+
+// Outbox numbers every message sent to one client or seat and keeps the most
+// recent ones, so a player who reconnects can resume from the last sequence
+// number they saw. A seat's outbox outlives the connection: while the player
+// is disconnected messages are kept but not delivered.
+type Outbox struct {
+	mu     sync.Mutex
+	next   uint64   // sequence number of the next message
+	recent [][]byte // the last maxOutbox messages, oldest first
+	client *Client  // where messages are delivered; nil while disconnected
+}
+
+// newOutbox creates an outbox delivering to c, which may be nil.
+func newOutbox(c *Client) *Outbox {
+	return &Outbox{next: 1, client: c}
+}
+
+// SendMsg numbers a message, keeps it and delivers it if a client is attached.
+func (o *Outbox) SendMsg(msg any) {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		slog.Error("failed to marshal message", "error", err)
+		return
+	}
+
+	if _, ok := msg.(ErrorResponseMsg); ok {
+		errorsSent.Add(1)
+	}
+
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	data = withSeq(data, o.next)
+	o.next++
+
+	o.recent = append(o.recent, data)
+	if len(o.recent) > maxOutbox {
+		o.recent = o.recent[1:]
+	}
+
+	if o.client != nil {
+		o.client.deliver(data)
+	}
+}
+
+// NextSeq returns the sequence number the next message will get.
+func (o *Outbox) NextSeq() uint64 {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	return o.next
+}
+
+// Attach starts delivering new messages to c.
+func (o *Outbox) Attach(c *Client) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.client = c
+}
+
+// Detach stops delivering messages to c, if it is the attached client.
+func (o *Outbox) Detach(c *Client) {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	if o.client == c {
+		o.client = nil
+	}
+}
+
+// Resume attaches c and delivers every message after lastSeq. It returns
+// false, attaching c without delivering anything, if lastSeq is not one this
+// outbox sent, the messages after it are no longer kept, or they would not
+// fit in c's send buffer.
+func (o *Outbox) Resume(c *Client, lastSeq uint64) bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+
+	o.client = c
+
+	if lastSeq == 0 || lastSeq >= o.next {
+		return false
+	}
+
+	missed := o.next - 1 - lastSeq
+	if missed > uint64(len(o.recent)) {
+		return false
+	}
+
+	// Delivering more than fits would disconnect the client as too slow
+	if missed > uint64(cap(c.send)-len(c.send)) {
+		return false
+	}
+
+	for _, data := range o.recent[uint64(len(o.recent))-missed:] {
+		c.deliver(data)
+	}
+
+	return true
+}
+
+// withSeq adds a seq field to a marshaled JSON object.
+func withSeq(data []byte, seq uint64) []byte {
+	if len(data) < 2 || data[0] != '{' {
+		return data
+	}
+
+	out := make([]byte, 0, len(data)+24)
+	out = append(out, `{"seq":`...)
+	out = strconv.AppendUint(out, seq, 10)
+	if len(data) > 2 {
+		out = append(out, ',')
+	}
+
+	return append(out, data[1:]...)
+}
@@ -0,0 +1,153 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestWithSeq(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{`{"type":"your_turn"}`, `{"seq":7,"type":"your_turn"}`},
+		{`{}`, `{"seq":7}`},
+		{`[]`, `[]`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.in, func(t *testing.T) {
+			if got := string(withSeq([]byte(tt.in), 7)); got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// seqs returns the seq of every message queued for c, oldest first.
+func seqs(t *testing.T, c *Client) []uint64 {
+	t.Helper()
+
+	var got []uint64
+	for {
+		select {
+		case raw := <-c.send:
+			var msg struct {
+				Seq uint64 `json:"seq"`
+			}
+			if err := json.Unmarshal(raw, &msg); err != nil {
+				t.Fatalf("invalid message: %v", err)
+			}
+			got = append(got, msg.Seq)
+		default:
+			return got
+		}
+	}
+}
+
+func TestOutboxResume(t *testing.T) {
+	tests := []struct {
+		name    string
+		sent    int
+		lastSeq uint64
+		ok      bool
+		want    int // messages resent
+	}{
+		{"nothing missed", 5, 5, true, 0},
+		{"some missed", 5, 2, true, 3},
+		{"no seq", 5, 0, false, 0},
+		{"seq from the future", 5, 9, false, 0},
+		{"too far behind", maxOutbox + 10, 5, false, 0},
+		{"oldest kept", maxOutbox + 10, 10, true, maxOutbox},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			o := newOutbox(nil)
+			for i := 0; i < tt.sent; i++ {
+				o.SendMsg(YourTurnMsg{Type: "your_turn"})
+			}
+
+			c := &Client{send: make(chan []byte, maxOutbox+16), out: o}
+			if ok := o.Resume(c, tt.lastSeq); ok != tt.ok {
+				t.Fatalf("expected resume %v, got %v", tt.ok, ok)
+			}
+
+			got := seqs(t, c)
+			if len(got) != tt.want {
+				t.Fatalf("expected %d messages resent, got %d", tt.want, len(got))
+			}
+
+			for i, seq := range got {
+				if seq != tt.lastSeq+uint64(i)+1 {
+					t.Errorf("expected seq %d, got %d", tt.lastSeq+uint64(i)+1, seq)
+				}
+			}
+
+			o.SendMsg(YourTurnMsg{Type: "your_turn"})
+			if next := seqs(t, c); len(next) != 1 || next[0] != uint64(tt.sent)+1 {
+				t.Errorf("expected delivery to the attached client to continue at %d, got %v", tt.sent+1, next)
+			}
+		})
+	}
+}
+
+func TestOutboxResumeBeyondSendBuffer(t *testing.T) {
+	o := newOutbox(nil)
+	for range sendBufferSize + 10 {
+		o.SendMsg(YourTurnMsg{Type: "your_turn"})
+	}
+
+	c := &Client{send: make(chan []byte, sendBufferSize), out: o}
+	if o.Resume(c, 5) {
+		t.Fatal("expected a resume larger than the send buffer to need a full resync")
+	}
+
+	if got := seqs(t, c); len(got) != 0 {
+		t.Errorf("expected nothing resent, got %d messages", len(got))
+	}
+
+	if c.closing.Load() {
+		t.Error("expected the client not to be disconnected")
+	}
+
+	if !o.Resume(c, 20) {
+		t.Error("expected a resume that fits the send buffer to succeed")
+	}
+}
+
+func TestReconnectResumesMissedMessages(t *testing.T) {
+	rm := NewRoomManager()
+	room, err := rm.CreateRoom(DefaultRules())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	alice, bob := newTestClient(rm), newTestClient(rm)
+	sendTestMsg(t, alice, JoinRoomMsg{Type: "join_room", Name: "Alice", RoomCode: room.Code})
+	sendTestMsg(t, bob, JoinRoomMsg{Type: "join_room", Name: "Bob", RoomCode: room.Code})
+	sendTestMsg(t, alice, TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(PrefFirst)})
+	sendTestMsg(t, bob, TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(PrefNoFirst)})
+
+	seen := seqs(t, bob)
+	lastSeq := seen[len(seen)-1]
+	token := room.SessionToken(2)
+	bob.cleanup()
+
+	sendTestMsg(t, alice, PlaceCardMsg{Type: "place_card", CardIndex: 0, SlotIndex: 0})
+
+	returning := newTestClient(rm)
+	sendTestMsg(t, returning, ReconnectMsg{Type: "reconnect", Name: "Bob", RoomCode: room.Code, Token: token, LastSeq: lastSeq})
+
+	want := []string{"card_placed", "your_turn", "player_joined"}
+	got := drainTypes(t, returning)
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("expected %v, got %v", want, got)
+		}
+	}
+}
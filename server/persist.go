@@ -0,0 +1,288 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// snapshotExt is the file extension of a persisted room.
+const snapshotExt = ".json"
+
+// roomSnapshot is the persisted form of a Room. Connections do not survive a
+// restart, so every human seat is restored as a disconnected player who can
+// take it back with reconnect.
+type roomSnapshot struct {
+	Code           string         `json:"code"`
+	Rules          Rules          `json:"rules"`
+	Created        time.Time      `json:"created"`
+	Seats          []seatSnapshot `json:"seats"`
+	PlayAgainReady []bool         `json:"playAgainReady"`
+	Chat           []ChatEntry    `json:"chat,omitempty"`
+	DailyDealt     bool           `json:"dailyDealt,omitempty"`
+	Game           *Game          `json:"game,omitempty"`
+}
+
+// seatSnapshot is one persisted seat. Name is "" for an empty seat.
+type seatSnapshot struct {
+	Name     string `json:"name"`
+	Token    string `json:"token,omitempty"`
+	Bot      bool   `json:"bot,omitempty"`
+	Strategy string `json:"strategy,omitempty"`
+	NextSeq  uint64 `json:"nextSeq,omitempty"` // numbering continues here, so old seqs never resume
+}
+
+// RoomStore persists room snapshots as one JSON file per room in a directory.
+type RoomStore struct {
+	dir string
+	mu  sync.Mutex
+}
+
+// NewRoomStore creates a RoomStore in dir, creating the directory if needed.
+func NewRoomStore(dir string) (*RoomStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("creating data directory: %w", err)
+	}
+
+	return &RoomStore{dir: dir}, nil
+}
+
+func (s *RoomStore) path(code string) string {
+	return filepath.Join(s.dir, code+snapshotExt)
+}
+
+// Save writes a room snapshot, replacing any earlier one. The file is written
+// to a temporary name first so a crash never leaves a half-written snapshot.
+func (s *RoomStore) Save(snap roomSnapshot) error {
+	data, err := json.Marshal(snap)
+	if err != nil {
+		return fmt.Errorf("marshaling room %s: %w", snap.Code, err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tmp := s.path(snap.Code) + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o644); err != nil {
+		return fmt.Errorf("writing room %s: %w", snap.Code, err)
+	}
+
+	if err := os.Rename(tmp, s.path(snap.Code)); err != nil {
+		return fmt.Errorf("writing room %s: %w", snap.Code, err)
+	}
+
+	return nil
+}
+
+// Delete removes a room's snapshot, if any.
+func (s *RoomStore) Delete(code string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := os.Remove(s.path(code)); err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("deleting room %s: %w", code, err)
+	}
+
+	return nil
+}
+
+// Load reads every room snapshot in the store. Unreadable snapshots are
+// logged and skipped.
+func (s *RoomStore) Load() ([]roomSnapshot, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return nil, fmt.Errorf("reading data directory: %w", err)
+	}
+
+	var snaps []roomSnapshot
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), snapshotExt) {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(s.dir, entry.Name()))
+		if err != nil {
+			slog.Warn("failed to read room snapshot", "file", entry.Name(), "error", err)
+			continue
+		}
+
+		var snap roomSnapshot
+		if err := json.Unmarshal(data, &snap); err != nil {
+			slog.Warn("failed to parse room snapshot", "file", entry.Name(), "error", err)
+			continue
+		}
+
+		snaps = append(snaps, snap)
+	}
+
+	return snaps, nil
+}
+
+// persist snapshots the room to its store.
+func (r *Room) persist() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.persistLocked()
+}
+
+// persistLocked snapshots the room to its store. Rooms without a store, and
+// rooms already removed, are not persisted. The caller must hold r.mu.
+func (r *Room) persistLocked() {
+	if r.store == nil || r.closed {
+		return
+	}
+
+	if err := r.store.Save(r.snapshotLocked()); err != nil {
+		slog.Error("failed to persist room", "room", r.Code, "error", err)
+	}
+}
+
+// snapshotLocked captures the room's state. The caller must hold r.mu.
+func (r *Room) snapshotLocked() roomSnapshot {
+	seats := make([]seatSnapshot, len(r.Players))
+	for i, name := range r.namesLocked() {
+		seats[i].Name = name
+		seats[i].Token = r.tokens[i]
+		if o := r.outboxes[i]; o != nil {
+			seats[i].NextSeq = o.NextSeq()
+		}
+		if p := r.Players[i]; p != nil && p.bot {
+			seats[i].Bot = true
+			seats[i].Strategy = p.strategy.Name()
+		}
+	}
+
+	return roomSnapshot{
+		Code:           r.Code,
+		Rules:          r.Rules,
+		Created:        r.Created,
+		Seats:          seats,
+		PlayAgainReady: append([]bool(nil), r.PlayAgainReady...),
+		Chat:           append([]ChatEntry(nil), r.chat...),
+		DailyDealt:     r.dailyDealt,
+		Game:           r.Game,
+	}
+}
+
+// Restore loads the rooms persisted in store and keeps persisting rooms to it.
+// Human players are restored as disconnected, each with a fresh grace period
+// to reconnect; bots are seated again. Restored games draw any further
+// randomness from a new source, and timed games keep their turn deadlines.
+func (rm *RoomManager) Restore(store *RoomStore) error {
+	snaps, err := store.Load()
+	if err != nil {
+		return err
+	}
+
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	rm.store = store
+
+	for _, snap := range snaps {
+		room, err := rm.restoreRoom(snap)
+		if err != nil {
+			slog.Warn("discarding room snapshot", "room", snap.Code, "error", err)
+			if err := store.Delete(snap.Code); err != nil {
+				slog.Error("failed to delete room snapshot", "room", snap.Code, "error", err)
+			}
+			continue
+		}
+
+		rm.rooms[room.Code] = room
+		room.armTurnTimer()
+		slog.Info("room restored", "code", room.Code)
+	}
+
+	return nil
+}
+
+// restoreRoom rebuilds a room from its snapshot. The caller must hold rm.mu.
+func (rm *RoomManager) restoreRoom(snap roomSnapshot) (*Room, error) {
+	if err := snap.Rules.Validate(); err != nil {
+		return nil, err
+	}
+
+	if len(snap.Seats) != snap.Rules.Players || len(snap.PlayAgainReady) != snap.Rules.Players {
+		return nil, fmt.Errorf("snapshot has %d seats for %d players", len(snap.Seats), snap.Rules.Players)
+	}
+
+	if game := snap.Game; game != nil && len(game.Hands) != snap.Rules.Players {
+		return nil, fmt.Errorf("snapshot game has %d hands for %d players", len(game.Hands), snap.Rules.Players)
+	}
+
+	room := newRoom(snap.Code, snap.Rules)
+	room.Created = snap.Created
+	room.Game = snap.Game
+	if room.Game != nil {
+		room.Game.restored = time.Now()
+	}
+	room.PlayAgainReady = snap.PlayAgainReady
+	room.chat = snap.Chat
+	room.dailyDealt = snap.DailyDealt
+	room.replays = rm.replays
+	room.store = rm.store
+	room.emotes = rm.emotePackLocked(snap.Rules.Emotes)
+	if snap.Rules.Daily {
+		room.daily = rm.daily
+	}
+
+	room.mu.Lock()
+	defer room.mu.Unlock()
+
+	for i, seat := range snap.Seats {
+		room.tokens[i] = seat.Token
+
+		switch {
+		case seat.Name == "":
+		case seat.Bot:
+			strategy, err := NewStrategy(seat.Strategy)
+			if err != nil {
+				return nil, err
+			}
+
+			bot := NewBotClient(rm, strategy)
+			bot.name = seat.Name
+			bot.playerNumber = i + 1
+			bot.room = room
+			room.Players[i] = bot
+			room.outboxes[i] = bot.out
+		default:
+			room.Disconnected[i] = &DisconnectedPlayer{Name: seat.Name, PlayerNumber: i + 1}
+			room.outboxes[i] = newOutbox(nil)
+			room.outboxes[i].next = max(seat.NextSeq, 1)
+			room.startGraceLocked(i, rm)
+		}
+	}
+
+	if room.emptyLocked() {
+		return nil, fmt.Errorf("no players left")
+	}
+
+	for _, p := range room.Players {
+		if p != nil && p.bot {
+			go p.runBot()
+		}
+	}
+
+	return room, nil
+}
+
+// SaveAll persists every room, for shutdown.
+func (rm *RoomManager) SaveAll() {
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+
+	for _, room := range rm.rooms {
+		room.persist()
+	}
+}
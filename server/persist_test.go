@@ -0,0 +1,190 @@
+package main
+
+import (
+	"os"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestRoomsSurviveRestart(t *testing.T) {
+	dir := t.TempDir()
+
+	store, err := NewRoomStore(dir)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rm := NewRoomManager()
+	if err := rm.Restore(store); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	room, err := rm.CreateRoom(DefaultRules())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	alice, bob := newTestClient(rm), newTestClient(rm)
+	sendTestMsg(t, alice, JoinRoomMsg{Type: "join_room", Name: "Alice", RoomCode: room.Code})
+	sendTestMsg(t, bob, JoinRoomMsg{Type: "join_room", Name: "Bob", RoomCode: room.Code})
+	sendTestMsg(t, alice, TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(PrefFirst)})
+	sendTestMsg(t, bob, TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(PrefNoFirst)})
+	sendTestMsg(t, alice, PlaceCardMsg{Type: "place_card", CardIndex: 0, SlotIndex: 3})
+
+	room.mu.Lock()
+	want := *room.Game
+	token := room.tokens[1]
+	room.mu.Unlock()
+
+	// A new manager stands in for the restarted server.
+	restarted := NewRoomManager()
+	if err := restarted.Restore(store); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	restored := restarted.GetRoom(room.Code)
+	if restored == nil {
+		t.Fatal("expected the room to be restored")
+	}
+
+	restored.mu.Lock()
+	got := *restored.Game
+	names := restored.namesLocked()
+	restored.mu.Unlock()
+
+	if !reflect.DeepEqual(got.Hands, want.Hands) || !reflect.DeepEqual(got.Board, want.Board) ||
+		got.Phase != want.Phase || got.CurrentTurn != want.CurrentTurn || len(got.Events) != len(want.Events) {
+		t.Error("expected the restored game to match the saved one")
+	}
+
+	if names[0] != "Alice" || names[1] != "Bob" {
+		t.Errorf("expected both players kept as disconnected, got %v", names)
+	}
+
+	returning := newTestClient(restarted)
+	sendTestMsg(t, returning, ReconnectMsg{Type: "reconnect", Name: "Bob", RoomCode: room.Code, Token: token})
+	if returning.room != restored || returning.playerNumber != 2 {
+		t.Fatal("expected Bob to reconnect to the restored room")
+	}
+
+	restarted.RemoveRoom(room.Code)
+	if _, err := os.Stat(store.path(room.Code)); !os.IsNotExist(err) {
+		t.Errorf("expected the snapshot to be deleted with the room, got %v", err)
+	}
+}
+
+func TestRestoredRoomArmsTurnTimer(t *testing.T) {
+	store, err := NewRoomStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rm := NewRoomManager()
+	if err := rm.Restore(store); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rules := DefaultRules()
+	rules.TurnSeconds = 30
+	room, err := rm.CreateRoom(rules)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	alice, bob := newTestClient(rm), newTestClient(rm)
+	sendTestMsg(t, alice, JoinRoomMsg{Type: "join_room", Name: "Alice", RoomCode: room.Code})
+	sendTestMsg(t, bob, JoinRoomMsg{Type: "join_room", Name: "Bob", RoomCode: room.Code})
+	sendTestMsg(t, alice, TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(PrefFirst)})
+	sendTestMsg(t, bob, TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(PrefNoFirst)})
+
+	// The server was down for longer than the turn lasts
+	room.mu.Lock()
+	for i := range room.Game.Events {
+		room.Game.Events[i].At = room.Game.Events[i].At.Add(-time.Hour)
+	}
+	room.persistLocked()
+	room.mu.Unlock()
+
+	// A new manager stands in for the restarted server.
+	restarted := NewRoomManager()
+	if err := restarted.Restore(store); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer restarted.RemoveRoom(room.Code)
+
+	restored := restarted.GetRoom(room.Code)
+	if restored == nil {
+		t.Fatal("expected the room to be restored")
+	}
+
+	restored.mu.Lock()
+	armed := restored.turnTimer != nil
+	deadline := restored.Game.TurnDeadline()
+	restored.mu.Unlock()
+
+	if !armed {
+		t.Error("expected the restored game's turn timer to be running")
+	}
+
+	if time.Until(deadline) < gracePeriod-time.Second {
+		t.Errorf("expected at least the grace period to act, deadline in %v", time.Until(deadline))
+	}
+}
+
+func TestRestoredSeatsKeepSeqs(t *testing.T) {
+	store, err := NewRoomStore(t.TempDir())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	rm := NewRoomManager()
+	if err := rm.Restore(store); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	room, err := rm.CreateRoom(DefaultRules())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	alice, bob := newTestClient(rm), newTestClient(rm)
+	sendTestMsg(t, alice, JoinRoomMsg{Type: "join_room", Name: "Alice", RoomCode: room.Code})
+	sendTestMsg(t, bob, JoinRoomMsg{Type: "join_room", Name: "Bob", RoomCode: room.Code})
+	sendTestMsg(t, alice, TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(PrefFirst)})
+	sendTestMsg(t, bob, TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(PrefNoFirst)})
+	sendTestMsg(t, alice, PlaceCardMsg{Type: "place_card", CardIndex: 0, SlotIndex: 3})
+
+	seen := seqs(t, bob)
+	lastSeq := seen[len(seen)-1]
+	token := room.SessionToken(2)
+
+	// A new manager stands in for the restarted server.
+	restarted := NewRoomManager()
+	if err := restarted.Restore(store); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	restored := restarted.GetRoom(room.Code)
+	if restored == nil {
+		t.Fatal("expected the room to be restored")
+	}
+
+	out := restored.outboxes[1]
+	if next := out.NextSeq(); next != lastSeq+1 {
+		t.Fatalf("expected numbering to continue at %d, got %d", lastSeq+1, next)
+	}
+
+	// Enough messages after the restart that a stale seq would look resumable
+	for range lastSeq + 1 {
+		out.SendMsg(YourTurnMsg{Type: "your_turn"})
+	}
+
+	returning := newTestClient(restarted)
+	sendTestMsg(t, returning, ReconnectMsg{Type: "reconnect", Name: "Bob", RoomCode: room.Code, Token: token, LastSeq: lastSeq - 1})
+
+	got := drainTypes(t, returning)
+	if len(got) != 2 || got[1] != "game_state" {
+		t.Errorf("expected a full resync for a seq from before the restart, got %v", got)
+	}
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"fmt"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// rateBudget is how often a client may send one message type: a burst of
+// up to burst messages, then rate messages per second.
+type rateBudget struct {
+	rate  float64
+	burst int
+}
+
+// messageBudgets are the per-client limits for each message type. Known
+// types not listed get defaultBudget's limit each; unknown types and
+// malformed frames share a single defaultBudget limit.
+var messageBudgets = map[string]rateBudget{
+	"create_room":   {rate: 0.2, burst: 3},
+	"join_room":     {rate: 0.5, burst: 5},
+	"reconnect":     {rate: 0.5, burst: 5},
+	"request_state": {rate: 0.5, burst: 3},
+	"peek":          {rate: 2, burst: 5},
+	"suggest_swap":  {rate: 1, burst: 3},
+	"respond_swap":  {rate: 1, burst: 3},
+	"undo_request":  {rate: 0.5, burst: 2},
+	"respond_undo":  {rate: 1, burst: 3},
+	"send_signal":   {rate: 1, burst: 3},
+	"send_chat":     {rate: 0.5, burst: 5},
+	"send_emote":    {rate: 1, burst: 5},
+	"echo":          {rate: 1, burst: 5},
+}
+
+var defaultBudget = rateBudget{rate: 5, burst: 10}
+
+// rateLimited counts client messages rejected for going over a rate limit.
+var rateLimited atomic.Uint64
+
+// Repeat offenders are disconnected once they go over their limits
+// maxStrikes times in a burst, with one strike forgiven every strikeDecay.
+const (
+	maxStrikes  = 10
+	strikeDecay = 10 * time.Second
+)
+
+// allowMessage reports whether the client may send a message of type typ
+// now. An over-limit message is answered with a rate_limited error, and a
+// client that keeps going over its limits is disconnected with a policy
+// violation. Bots are never limited.
+func (c *Client) allowMessage(typ string) bool {
+	if c.bot {
+		return true
+	}
+
+	// Keyed by known type so made-up types cannot each get a fresh bucket
+	typ = knownMessageType(typ)
+
+	budget, ok := messageBudgets[typ]
+	if !ok {
+		budget = defaultBudget
+	}
+
+	if c.limits == nil {
+		c.limits = make(map[string]*tokenBucket)
+		c.strikes = newTokenBucket(1/strikeDecay.Seconds(), maxStrikes)
+	}
+
+	limit := c.limits[typ]
+	if limit == nil {
+		limit = newTokenBucket(budget.rate, budget.burst)
+		c.limits[typ] = limit
+	}
+
+	now := time.Now()
+	if limit.allow(now) {
+		return true
+	}
+
+	rateLimited.Add(1)
+
+	if !c.strikes.allow(now) {
+		if c.closeWith(websocket.ClosePolicyViolation, "rate limit exceeded") {
+			slog.Warn("disconnecting client over rate limits", "player", c.name, "type", typ)
+		}
+
+		return false
+	}
+
+	c.SendMsg(ErrorResponseMsg{
+		Type:       "error",
+		Message:    fmt.Sprintf("too many %s messages, slow down", typ),
+		Code:       "rate_limited",
+		Of:         typ,
+		RetryAfter: limit.retryAfter().Milliseconds(),
+	})
+
+	return false
+}
+
+// tokenBucket is a rate limiter allowing bursts of up to burst events that
+// refills at rate events per second. It is not safe for concurrent use; each
+// client's messages are handled one at a time.
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// newTokenBucket creates a full token bucket.
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	return &tokenBucket{rate: rate, burst: float64(burst), tokens: float64(burst)}
+}
+
+// allow reports whether an event at now is within the limit, and counts it
+// if so.
+func (b *tokenBucket) allow(now time.Time) bool {
+	if !b.last.IsZero() {
+		b.tokens = min(b.burst, b.tokens+now.Sub(b.last).Seconds()*b.rate)
+	}
+
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+	return true
+}
+
+// retryAfter returns how long until the bucket has a token again, as of its
+// last event.
+func (b *tokenBucket) retryAfter() time.Duration {
+	if b.tokens >= 1 {
+		return 0
+	}
+
+	return time.Duration((1 - b.tokens) / b.rate * float64(time.Second))
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestTokenBucket(t *testing.T) {
+	start := time.Now()
+	b := newTokenBucket(2, 3)
+
+	for i := range 3 {
+		if !b.allow(start) {
+			t.Fatalf("expected event %d of the burst to be allowed", i+1)
+		}
+	}
+
+	if b.allow(start) {
+		t.Error("expected the bucket to be empty after the burst")
+	}
+
+	if !b.allow(start.Add(500 * time.Millisecond)) {
+		t.Error("expected one token back after half a second")
+	}
+
+	if b.allow(start.Add(600 * time.Millisecond)) {
+		t.Error("expected no token back yet")
+	}
+
+	later := start.Add(time.Hour)
+	for i := range 3 {
+		if !b.allow(later) {
+			t.Fatalf("expected a full burst after a long pause, failed at %d", i+1)
+		}
+	}
+
+	if b.allow(later) {
+		t.Error("expected the bucket never to hold more than its burst")
+	}
+}
+
+func TestAllowMessage(t *testing.T) {
+	c := newTestClient(NewRoomManager())
+	burst := messageBudgets["peek"].burst
+
+	for i := range burst {
+		if !c.allowMessage("peek") {
+			t.Fatalf("expected peek %d of the burst to be allowed", i+1)
+		}
+	}
+
+	if c.allowMessage("peek") {
+		t.Fatal("expected the peek past the burst to be limited")
+	}
+
+	var msg ErrorResponseMsg
+	if err := json.Unmarshal(<-c.send, &msg); err != nil {
+		t.Fatalf("invalid message: %v", err)
+	}
+
+	if msg.Code != "rate_limited" || msg.Of != "peek" || msg.RetryAfter <= 0 {
+		t.Errorf("unexpected rate limit error: %+v", msg)
+	}
+
+	if !c.allowMessage("place_card") {
+		t.Error("expected other message types to have their own budget")
+	}
+
+	for i := range defaultBudget.burst + 1 {
+		allowed := c.allowMessage(fmt.Sprintf("made_up_%d", i))
+		if allowed != (i < defaultBudget.burst) {
+			t.Fatalf("unknown type %d: allowed = %v", i, allowed)
+		}
+	}
+
+	if len(c.limits) != 3 {
+		t.Errorf("expected unknown types to share one bucket, got %d buckets", len(c.limits))
+	}
+
+	// Malformed frames count against the same bucket
+	drainTypes(t, c)
+	c.handleMessage([]byte("not json"))
+	if err := json.Unmarshal(<-c.send, &msg); err != nil {
+		t.Fatalf("invalid message: %v", err)
+	}
+
+	if msg.Code != "rate_limited" || msg.Of != unknownMessageType {
+		t.Errorf("expected a malformed frame to be limited, got %+v", msg)
+	}
+
+	bot := &Client{bot: true}
+	for range 100 {
+		if !bot.allowMessage("peek") {
+			t.Fatal("expected bots never to be limited")
+		}
+	}
+}
+
+func TestRepeatOffenderDisconnected(t *testing.T) {
+	server := httptest.NewServer(handleWebSocket(NewRoomManager()))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer conn.Close()
+
+	for range messageBudgets["peek"].burst + maxStrikes + 1 {
+		if err := conn.WriteJSON(PeekMsg{Type: "peek"}); err != nil {
+			t.Fatalf("failed to send: %v", err)
+		}
+	}
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	for {
+		if _, _, err := conn.ReadMessage(); err != nil {
+			if !websocket.IsCloseError(err, websocket.ClosePolicyViolation) {
+				t.Errorf("expected a policy violation close, got %v", err)
+			}
+
+			return
+		}
+	}
+}
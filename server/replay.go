@@ -0,0 +1,203 @@
+package main
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// maxReplays caps how many finished or abandoned games are kept for replay.
+const maxReplays = 500
+
+// Replay actions, in the order they can happen in a game.
+const (
+	ReplayDeal           = "deal"
+	ReplayPick           = "turn_order_pick"
+	ReplayConflict       = "turn_order_conflict"
+	ReplayPlacementStart = "placement_start"
+	ReplayPlace          = "place_card"
+	ReplayPass           = "pass"
+	ReplayPeek           = "peek"
+	ReplaySuggestSwap    = "suggest_swap"
+	ReplayRespondSwap    = "respond_swap"
+	ReplaySkipSwap       = "skip_swap"
+	ReplayUndoRequest    = "undo_request"
+	ReplayRespondUndo    = "respond_undo"
+	ReplaySignal         = "signal"
+	ReplayReveal         = "reveal"
+)
+
+// ReplayEvent is one recorded game action. Player is 0 for actions taken by
+// the game itself, such as the deal. Details depend on Action.
+type ReplayEvent struct {
+	At      time.Time       `json:"at"`
+	Action  string          `json:"action"`
+	Player  int             `json:"player,omitempty"`
+	Details json.RawMessage `json:"details,omitempty"`
+}
+
+// DealDetails records the hands and the suit order a game was dealt.
+type DealDetails struct {
+	Hands     [][]Card   `json:"hands"`
+	SuitOrder []Suit     `json:"suitOrder"`
+	SuitHints []SuitHint `json:"suitHints,omitempty"`
+}
+
+// PickDetails records a turn order pick.
+type PickDetails struct {
+	Preference Preference `json:"preference"`
+}
+
+// PlacementStartDetails records who goes first.
+type PlacementStartDetails struct {
+	FirstPlayer int `json:"firstPlayer"`
+}
+
+// PlaceDetails records a placed card.
+type PlaceDetails struct {
+	CardIndex int  `json:"cardIndex"`
+	SlotIndex int  `json:"slotIndex"`
+	Card      Card `json:"card"`
+}
+
+// PeekDetails records a peek at a player's own card.
+type PeekDetails struct {
+	SlotIndex int  `json:"slotIndex"`
+	Card      Card `json:"card"`
+}
+
+// SwapDetails records a swap suggestion.
+type SwapDetails struct {
+	SlotA int `json:"slotA"`
+	SlotB int `json:"slotB"`
+}
+
+// RespondDetails records the answer to a swap suggestion or an undo request.
+type RespondDetails struct {
+	Accept bool `json:"accept"`
+}
+
+// SignalDetails records a signal a player attached to one of their cards.
+type SignalDetails struct {
+	SlotIndex int    `json:"slotIndex"`
+	Kind      string `json:"kind"`
+}
+
+// RevealDetails records the final board and result.
+type RevealDetails struct {
+	Order []RevealEntry `json:"order"`
+	Win   bool          `json:"win"`
+}
+
+// record appends an action to the game's replay log.
+func (g *Game) record(action string, player int, details any) {
+	event := ReplayEvent{At: time.Now(), Action: action, Player: player}
+	if details != nil {
+		raw, err := json.Marshal(details)
+		if err != nil {
+			slog.Error("failed to marshal replay details", "action", action, "error", err)
+		}
+
+		event.Details = raw
+	}
+
+	g.Events = append(g.Events, event)
+}
+
+// lastAction returns the most recent recorded action other than a peek or a
+// signal, which any player may make at any time, or nil if there is none.
+func (g *Game) lastAction() *ReplayEvent {
+	for i := len(g.Events) - 1; i >= 0; i-- {
+		if action := g.Events[i].Action; action != ReplayPeek && action != ReplaySignal {
+			return &g.Events[i]
+		}
+	}
+
+	return nil
+}
+
+// Replay is the full recording of one game.
+type Replay struct {
+	ID      string        `json:"id"`
+	Room    string        `json:"room"`
+	Rules   Rules         `json:"rules"`
+	Players []string      `json:"players"`
+	Events  []ReplayEvent `json:"events"`
+}
+
+// archiveLocked saves the room's current game, if any, for replay.
+// The caller must hold r.mu.
+func (r *Room) archiveLocked() {
+	if r.Game == nil {
+		return
+	}
+
+	r.replays.Save(Replay{
+		ID:      r.Game.ID,
+		Room:    r.Code,
+		Rules:   r.Rules,
+		Players: r.namesLocked(),
+		Events:  append([]ReplayEvent(nil), r.Game.Events...),
+	})
+}
+
+// ReplayStore keeps the most recent game recordings by game ID.
+type ReplayStore struct {
+	mu      sync.Mutex
+	replays map[string]Replay
+	order   []string // IDs oldest first, for eviction
+}
+
+// NewReplayStore creates an empty ReplayStore.
+func NewReplayStore() *ReplayStore {
+	return &ReplayStore{replays: make(map[string]Replay)}
+}
+
+// Save stores a replay, replacing any earlier recording of the same game and
+// evicting the oldest once maxReplays are kept. A nil store discards it.
+func (s *ReplayStore) Save(replay Replay) {
+	if s == nil {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, exists := s.replays[replay.ID]; !exists {
+		s.order = append(s.order, replay.ID)
+	}
+
+	s.replays[replay.ID] = replay
+
+	for len(s.order) > maxReplays {
+		delete(s.replays, s.order[0])
+		s.order = s.order[1:]
+	}
+}
+
+// Get returns the replay of a game and whether it was found.
+func (s *ReplayStore) Get(id string) (Replay, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	replay, ok := s.replays[id]
+	return replay, ok
+}
+
+// handleReplay serves the recording of the game named by the id path value.
+func handleReplay(rooms *RoomManager) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		replay, ok := rooms.replays.Get(r.PathValue("id"))
+		if !ok {
+			http.Error(w, "replay not found", http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(replay); err != nil {
+			slog.Warn("failed to write replay", "error", err)
+		}
+	}
+}
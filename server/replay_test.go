@@ -0,0 +1,134 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGameRecordsActions(t *testing.T) {
+	rules := DefaultRules()
+	rules.HandSize = 2
+	g, err := NewGameWithSource(rules, NewSeededSource(1))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	g.SetPick(1, PrefFirst)
+	g.SetPick(2, PrefFirst)
+	g.ResetPicks()
+	g.SetPick(1, PrefFirst)
+	g.SetPick(2, PrefNoFirst)
+	g.StartPlacement(1)
+
+	steps := []error{
+		g.PlaceCard(1, 0, 0),
+		g.UsePass(2),
+		g.PlaceCard(2, 0, 0), // fails: not recorded
+		g.PlaceCard(1, 1, 1),
+		g.PlaceCard(2, 0, 2),
+		g.SuggestSwap(1, 0, 1),
+		g.RespondSwap(2, false),
+		g.PlaceCard(2, 1, 3),
+		g.SkipSwap(1),
+		g.SkipSwap(2),
+	}
+	for i, err := range steps {
+		if err != nil && i != 2 {
+			t.Fatalf("step %d: unexpected error: %v", i, err)
+		}
+	}
+
+	g.FinalizeReveal()
+
+	want := []string{
+		ReplayDeal,
+		ReplayPick, ReplayPick, ReplayConflict, ReplayPick, ReplayPick, ReplayPlacementStart,
+		ReplayPlace, ReplayPass, ReplayPlace, ReplayPlace,
+		ReplaySuggestSwap, ReplayRespondSwap, ReplayPlace,
+		ReplaySkipSwap, ReplaySkipSwap, ReplayReveal,
+	}
+
+	if len(g.Events) != len(want) {
+		t.Fatalf("expected %d events, got %d", len(want), len(g.Events))
+	}
+
+	for i, event := range g.Events {
+		if event.Action != want[i] {
+			t.Errorf("event %d: action = %q, want %q", i, event.Action, want[i])
+		}
+	}
+
+	var place PlaceDetails
+	if err := json.Unmarshal(g.Events[7].Details, &place); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if g.Events[7].Player != 1 || place.SlotIndex != 0 || place.Card != g.Hands[0][0] {
+		t.Errorf("unexpected place_card event: player %d, %+v", g.Events[7].Player, place)
+	}
+}
+
+func TestReplayStoreEvictsOldest(t *testing.T) {
+	store := NewReplayStore()
+	for i := 0; i < maxReplays+1; i++ {
+		store.Save(Replay{ID: fmt.Sprint(i)})
+	}
+
+	// Saving a known game again must not count twice
+	store.Save(Replay{ID: "1", Room: "ABCD"})
+
+	if _, ok := store.Get("0"); ok {
+		t.Error("expected the oldest replay to be evicted")
+	}
+
+	if replay, ok := store.Get("1"); !ok || replay.Room != "ABCD" {
+		t.Error("expected the replay to be replaced in place")
+	}
+
+	if _, ok := store.Get(fmt.Sprint(maxReplays)); !ok {
+		t.Error("expected the newest replay to be kept")
+	}
+}
+
+func TestHandleReplay(t *testing.T) {
+	rm := NewRoomManager()
+	room, err := rm.CreateRoom(DefaultRules())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	room.Game, err = NewGame(room.Rules)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	id := room.Game.ID
+	rm.RemoveRoom(room.Code)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /api/replays/{id}", handleReplay(rm))
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/replays/"+id, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d", rec.Code)
+	}
+
+	var replay Replay
+	if err := json.Unmarshal(rec.Body.Bytes(), &replay); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if replay.ID != id || replay.Room != room.Code || len(replay.Events) != 1 || replay.Events[0].Action != ReplayDeal {
+		t.Errorf("unexpected replay of removed room: %+v", replay)
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/api/replays/missing", nil))
+	if rec.Code != http.StatusNotFound {
+		t.Errorf("expected 404 for unknown game, got %d", rec.Code)
+	}
+}
@@ -2,9 +2,11 @@ package main
 
 import (
 	"crypto/rand"
+	"crypto/subtle"
 	"fmt"
 	"log/slog"
 	"math/big"
+	"slices"
 	"sync"
 	"time"
 )
@@ -16,6 +18,9 @@ const (
 
 	// gracePeriod is how long a room stays alive after a player disconnects.
 	gracePeriod = 30 * time.Second
+
+	// maxSpectators caps how many clients can watch a room at once.
+	maxSpectators = 8
 )
 
 // DisconnectedPlayer holds info about a player who disconnected but may reconnect.
@@ -24,42 +29,115 @@ type DisconnectedPlayer struct {
 	PlayerNumber int
 }
 
-// Room represents a game room with up to two players.
+// Room represents a game room with one seat per player in Rules.Players.
+// Per-seat slices are indexed by playerNumber-1.
 type Room struct {
 	Code           string
-	Players        [2]*Client
+	Rules          Rules     // chosen by the creator; fixed for the room's lifetime
+	Created        time.Time // also picks the daily challenge deal
+	Players        []*Client
 	Game           *Game
-	PlayAgainReady [2]bool // tracks which players want a rematch
+	PlayAgainReady []bool    // tracks which players want a rematch
+	Spectators     []*Client // watching without a seat; never sent hands
 	mu             sync.Mutex
 
 	// Disconnection tracking
-	Disconnected [2]*DisconnectedPlayer // info about disconnected players
-	graceTimers  [2]*time.Timer         // cleanup timers per player slot
+	Disconnected []*DisconnectedPlayer // info about disconnected players
+	graceTimers  []*time.Timer         // cleanup timers per player slot
+	tokens       []string              // secret session token per seat, required to reconnect
+	outboxes     []*Outbox             // per seat; kept while the player is disconnected
+	turnTimer    *time.Timer           // makes the timeout moves; nil without a turn timer
+	chat         []ChatEntry           // recent chat, oldest first; see maxChatHistory
+	emotes       []string              // the room's emote pack; fixed for the room's lifetime
+
+	daily      *DailyBoard  // where results go; only set for daily challenge rooms
+	dailyDealt bool         // the daily challenge deal has been dealt; see newGame
+	replays    *ReplayStore // where finished and abandoned games are archived
+	store      *RoomStore   // where the room is persisted; nil if persistence is off
+	closed     bool         // removed from the RoomManager; no longer persisted
 }
 
-// AddPlayer adds a client to the room. Returns the assigned player number (1 or 2).
-// Rejects duplicate names atomically within the same lock.
+// newRoom creates an empty room with a seat for each player in rules.
+func newRoom(code string, rules Rules) *Room {
+	return &Room{
+		Code:           code,
+		Rules:          rules,
+		Created:        time.Now(),
+		Players:        make([]*Client, rules.Players),
+		PlayAgainReady: make([]bool, rules.Players),
+		Disconnected:   make([]*DisconnectedPlayer, rules.Players),
+		graceTimers:    make([]*time.Timer, rules.Players),
+		tokens:         make([]string, rules.Players),
+		outboxes:       make([]*Outbox, rules.Players),
+	}
+}
+
+// AddPlayer seats a client in the first free seat and issues the seat a new
+// session token. The client's outbox becomes the seat's. Returns the assigned
+// player number. Rejects duplicate names atomically within the same lock.
+// Seats held for disconnected players are not free, and nobody is seated
+// while a game is in progress.
 func (r *Room) AddPlayer(c *Client, name string) (int, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	// Check for duplicate name
-	for _, p := range r.Players {
-		if p != nil && p.name == name {
+	if r.Game != nil && r.Game.Phase != PhaseGameOver {
+		return 0, fmt.Errorf("game already in progress")
+	}
+
+	// Check for duplicate name, including players who may still reconnect
+	for _, n := range r.namesLocked() {
+		if n == name {
 			return 0, fmt.Errorf("name already taken in this room")
 		}
 	}
 
-	if r.Players[0] == nil {
-		r.Players[0] = c
-		return 1, nil
+	// A disconnected player's seat stays theirs until the grace period ends
+	for i, p := range r.Players {
+		if p == nil && r.Disconnected[i] == nil {
+			if c.out == nil {
+				c.out = newOutbox(c)
+			}
+
+			r.Players[i] = c
+			r.tokens[i] = rand.Text()
+			r.outboxes[i] = c.out
+			return i + 1, nil
+		}
+	}
+
+	return 0, fmt.Errorf("room is full")
+}
+
+// AddSpectator lets a client watch the room without taking a seat.
+func (r *Room) AddSpectator(c *Client) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.Spectators) >= maxSpectators {
+		return fmt.Errorf("room %s has too many spectators", r.Code)
 	}
-	if r.Players[1] == nil {
-		r.Players[1] = c
-		return 2, nil
+
+	if c.out == nil {
+		c.out = newOutbox(c)
 	}
 
-	return 0, fmt.Errorf("room %s is full", r.Code)
+	r.Spectators = append(r.Spectators, c)
+	return nil
+}
+
+// RemoveSpectator stops a client watching the room. Spectators have no seat
+// to hold, so there is no grace period.
+func (r *Room) RemoveSpectator(c *Client) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for i, s := range r.Spectators {
+		if s == c {
+			r.Spectators = append(r.Spectators[:i], r.Spectators[i+1:]...)
+			break
+		}
+	}
 }
 
 // RemovePlayer removes a client from the room permanently.
@@ -71,6 +149,8 @@ func (r *Room) RemovePlayer(c *Client) {
 		if p == c {
 			r.Players[i] = nil
 			r.Disconnected[i] = nil
+			r.tokens[i] = ""
+			r.outboxes[i] = nil
 
 			if r.graceTimers[i] != nil {
 				r.graceTimers[i].Stop()
@@ -84,15 +164,19 @@ func (r *Room) RemovePlayer(c *Client) {
 
 // ExitPlayer removes a player who intentionally left the game.
 // Unlike DisconnectPlayer, there is no grace period — the player is removed immediately
-// and the game is reset so the remaining player can wait for a new partner.
+// and the game is reset so the remaining players can wait for a new partner.
 func (r *Room) ExitPlayer(c *Client, rm *RoomManager) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	r.archiveLocked()
+
 	for i, p := range r.Players {
 		if p == c {
 			r.Players[i] = nil
 			r.Disconnected[i] = nil
+			r.tokens[i] = ""
+			r.outboxes[i] = nil
 
 			if r.graceTimers[i] != nil {
 				r.graceTimers[i].Stop()
@@ -105,12 +189,14 @@ func (r *Room) ExitPlayer(c *Client, rm *RoomManager) {
 
 	// Reset game so room can accept a new partner
 	r.Game = nil
-	r.PlayAgainReady = [2]bool{}
+	r.PlayAgainReady = make([]bool, len(r.Players))
 
-	// Clean up any disconnected partner (no point reconnecting to a dead game)
+	// Clean up any disconnected partners (no point reconnecting to a dead game)
 	for i := range r.Disconnected {
 		if r.Disconnected[i] != nil {
 			r.Disconnected[i] = nil
+			r.tokens[i] = ""
+			r.outboxes[i] = nil
 
 			if r.graceTimers[i] != nil {
 				r.graceTimers[i].Stop()
@@ -119,8 +205,8 @@ func (r *Room) ExitPlayer(c *Client, rm *RoomManager) {
 		}
 	}
 
-	// If room is completely empty, remove it
-	if r.Players[0] == nil && r.Players[1] == nil {
+	// If no human is left, remove the room
+	if r.emptyLocked() {
 		go rm.RemoveRoom(r.Code)
 	}
 }
@@ -139,7 +225,7 @@ func (r *Room) DisconnectPlayer(c *Client, rm *RoomManager) int {
 		}
 	}
 
-	if idx == -1 {
+	if idx == -1 || r.closed {
 		return -1
 	}
 
@@ -148,45 +234,67 @@ func (r *Room) DisconnectPlayer(c *Client, rm *RoomManager) int {
 		PlayerNumber: c.playerNumber,
 	}
 	r.Players[idx] = nil
+	r.outboxes[idx].Detach(c)
+	r.startGraceLocked(idx, rm)
 
-	// Start grace timer — permanently remove after timeout
-	r.graceTimers[idx] = time.AfterFunc(gracePeriod, func() {
+	return idx
+}
+
+// startGraceLocked starts the grace timer for a disconnected seat: once it
+// expires the seat is freed for good. The caller must hold r.mu.
+func (r *Room) startGraceLocked(idx int, rm *RoomManager) {
+	var timer *time.Timer
+	timer = time.AfterFunc(gracePeriod, func() {
 		r.mu.Lock()
+		// The player may have reconnected while the timer fired
+		if r.graceTimers[idx] != timer {
+			r.mu.Unlock()
+			return
+		}
+
 		r.Disconnected[idx] = nil
 		r.graceTimers[idx] = nil
-		empty := r.Players[0] == nil && r.Players[1] == nil &&
-			r.Disconnected[0] == nil && r.Disconnected[1] == nil
+		r.tokens[idx] = ""
+		r.outboxes[idx] = nil
+		empty := r.emptyLocked()
+		r.persistLocked()
 		r.mu.Unlock()
 
+		graceExpirations.Add(1)
+
 		if empty {
 			rm.RemoveRoom(r.Code)
 		}
 
 		slog.Info("grace period expired", "room", r.Code, "slot", idx+1)
 	})
-
-	return idx
+	r.graceTimers[idx] = timer
 }
 
-// ReconnectPlayer restores a disconnected player into the room.
+// ReconnectPlayer restores a disconnected player into the room. The token
+// must match the seat's session token, which is then rotated. The client
+// takes over the seat's outbox; the caller attaches it with Outbox.Resume.
 // Returns the player number and true if successful, or 0 and false if not found.
-func (r *Room) ReconnectPlayer(c *Client, name string) (int, bool) {
+func (r *Room) ReconnectPlayer(c *Client, name, token string) (int, bool) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
 	for i, d := range r.Disconnected {
-		if d != nil && d.Name == name {
+		if d != nil && d.Name == name && validToken(r.tokens[i], token) {
 			c.name = d.Name
 			c.playerNumber = d.PlayerNumber
 			c.room = r
 			r.Players[i] = c
 			r.Disconnected[i] = nil
+			r.tokens[i] = rand.Text()
+			c.out = r.outboxes[i]
 
 			if r.graceTimers[i] != nil {
 				r.graceTimers[i].Stop()
 				r.graceTimers[i] = nil
 			}
 
+			reconnects.Add(1)
 			return d.PlayerNumber, true
 		}
 	}
@@ -194,27 +302,129 @@ func (r *Room) ReconnectPlayer(c *Client, name string) (int, bool) {
 	return 0, false
 }
 
-// IsEmpty reports whether the room has no players and no disconnected players.
+// SessionToken returns the session token of a seat.
+func (r *Room) SessionToken(playerNumber int) string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.tokens[playerNumber-1]
+}
+
+// validToken reports whether got matches a seat's token in constant time.
+// A seat without a token cannot be reconnected to.
+func validToken(want, got string) bool {
+	return want != "" && subtle.ConstantTimeCompare([]byte(want), []byte(got)) == 1
+}
+
+// IsEmpty reports whether the room has no human players and no disconnected players.
+// Bot seats do not keep a room alive.
 func (r *Room) IsEmpty() bool {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	return r.Players[0] == nil && r.Players[1] == nil &&
-		r.Disconnected[0] == nil && r.Disconnected[1] == nil
+	return r.emptyLocked()
+}
+
+// emptyLocked is IsEmpty without locking. The caller must hold r.mu.
+func (r *Room) emptyLocked() bool {
+	for i, p := range r.Players {
+		if (p != nil && !p.bot) || r.Disconnected[i] != nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// OpenSeats returns how many seats are free to join.
+func (r *Room) OpenSeats() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	open := 0
+	for i, p := range r.Players {
+		if p == nil && r.Disconnected[i] == nil {
+			open++
+		}
+	}
+
+	return open
 }
 
-// Partner returns the other player in the room, or nil.
-func (r *Room) Partner(c *Client) *Client {
+// Partners returns the other connected players in the room in seat order.
+func (r *Room) Partners(c *Client) []*Client {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
+	var partners []*Client
 	for _, p := range r.Players {
 		if p != nil && p != c {
-			return p
+			partners = append(partners, p)
 		}
 	}
 
-	return nil
+	return partners
+}
+
+// Others returns the outboxes of everyone else in the room, seats in order
+// followed by spectators, for public notifications such as disconnects.
+func (r *Room) Others(c *Client) []*Outbox {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var others []*Outbox
+	for _, o := range r.audienceLocked() {
+		if o != nil && o != c.out {
+			others = append(others, o)
+		}
+	}
+
+	return others
+}
+
+// playersLocked returns a copy of the seat outboxes, with nil for empty
+// seats, for sending after the lock is released. Disconnected seats keep
+// their outbox so they can catch up on reconnect. The caller must hold r.mu.
+func (r *Room) playersLocked() []*Outbox {
+	return append([]*Outbox(nil), r.outboxes...)
+}
+
+// audienceLocked returns the seat outboxes followed by the spectators', for
+// broadcasting public events after the lock is released. Private messages
+// such as hands must go to playersLocked instead. The caller must hold r.mu.
+func (r *Room) audienceLocked() []*Outbox {
+	audience := r.playersLocked()
+	for _, s := range r.Spectators {
+		audience = append(audience, s.out)
+	}
+
+	return audience
+}
+
+// namesLocked returns the player names by seat, including disconnected
+// players, with "" for empty seats. The caller must hold r.mu.
+func (r *Room) namesLocked() []string {
+	names := make([]string, len(r.Players))
+	for i, p := range r.Players {
+		if p != nil {
+			names[i] = p.name
+		} else if d := r.Disconnected[i]; d != nil {
+			names[i] = d.Name
+		}
+	}
+
+	return names
+}
+
+// fullLocked reports whether every seat is taken. The caller must hold r.mu.
+func (r *Room) fullLocked() bool {
+	for _, p := range r.Players {
+		if p == nil {
+			return false
+		}
+	}
+
+	return true
 }
 
 // StartGame creates and initializes a new game for the room.
@@ -225,11 +435,11 @@ func (r *Room) StartGame() (*Game, error) {
 	if r.Game != nil {
 		return nil, fmt.Errorf("room %s: game already started", r.Code)
 	}
-	if r.Players[0] == nil || r.Players[1] == nil {
-		return nil, fmt.Errorf("room %s: both players required to start", r.Code)
+	if !r.fullLocked() {
+		return nil, fmt.Errorf("room %s: all %d players required to start", r.Code, len(r.Players))
 	}
 
-	game, err := NewGame()
+	game, err := r.newGame()
 	if err != nil {
 		return nil, err
 	}
@@ -238,6 +448,38 @@ func (r *Room) StartGame() (*Game, error) {
 	return game, nil
 }
 
+// newGame deals a game under the room's rules. A daily challenge room deals
+// its first game from the seed of the day it was created, so every pair gets
+// the same hands. Later games are dealt at random: the players already know
+// the daily deal.
+func (r *Room) newGame() (*Game, error) {
+	var game *Game
+	var err error
+	if r.Rules.Daily && !r.dailyDealt {
+		game, err = NewGameWithSource(r.Rules, NewSeededSource(DailySeed(DailyDate(r.Created))))
+		if err == nil {
+			game.Daily = true
+			r.dailyDealt = true
+		}
+	} else {
+		game, err = NewGame(r.Rules)
+	}
+
+	if err == nil {
+		gamesStarted.Add(1)
+	}
+
+	return game, err
+}
+
+// Closed reports whether the room has been removed from its RoomManager.
+func (r *Room) Closed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.closed
+}
+
 // GamePhase returns the current game phase, or PhaseLobby if no game exists.
 func (r *Room) GamePhase() Phase {
 	r.mu.Lock()
@@ -255,35 +497,109 @@ func (r *Room) ResetGame() (*Game, error) {
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
-	if r.Players[0] == nil || r.Players[1] == nil {
-		return nil, fmt.Errorf("room %s: both players required for rematch", r.Code)
+	if !r.fullLocked() {
+		return nil, fmt.Errorf("room %s: all %d players required for rematch", r.Code, len(r.Players))
 	}
 
-	game, err := NewGame()
+	game, err := r.newGame()
 	if err != nil {
 		return nil, err
 	}
 
 	r.Game = game
-	r.PlayAgainReady = [2]bool{}
+	r.PlayAgainReady = make([]bool, len(r.Players))
 	return game, nil
 }
 
 // RoomManager manages active game rooms.
 type RoomManager struct {
-	rooms map[string]*Room
-	mu    sync.RWMutex
+	rooms    map[string]*Room
+	mu       sync.RWMutex
+	daily    *DailyBoard
+	replays  *ReplayStore
+	store    *RoomStore         // set by Restore; nil if persistence is off
+	emotes   EmotePacks         // packs rooms choose their emotes from
+	clients  map[*Client]bool   // every connected client, for server-wide notices
+	shutdown *ServerShutdownMsg // set once the server starts draining; see Drain
 }
 
 // NewRoomManager creates a new RoomManager.
 func NewRoomManager() *RoomManager {
 	return &RoomManager{
-		rooms: make(map[string]*Room),
+		rooms:   make(map[string]*Room),
+		daily:   NewDailyBoard(),
+		replays: NewReplayStore(),
+		emotes:  DefaultEmotePacks(),
+		clients: make(map[*Client]bool),
 	}
 }
 
-// CreateRoom creates a new room with a unique code.
-func (rm *RoomManager) CreateRoom() (*Room, error) {
+// Track registers a connected client for server-wide notices.
+func (rm *RoomManager) Track(c *Client) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	rm.clients[c] = true
+
+	// Clients connecting while the server drains learn of it right away
+	if rm.shutdown != nil {
+		c.notify(*rm.shutdown)
+	}
+}
+
+// Untrack forgets a client whose connection closed.
+func (rm *RoomManager) Untrack(c *Client) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	delete(rm.clients, c)
+}
+
+// Notify sends a connection-level notice to every connected client and
+// returns how many there were.
+func (rm *RoomManager) Notify(msg any) int {
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+
+	for c := range rm.clients {
+		c.notify(msg)
+	}
+
+	return len(rm.clients)
+}
+
+// UseEmotePacks replaces the emote packs new rooms choose from.
+func (rm *RoomManager) UseEmotePacks(packs EmotePacks) {
+	rm.mu.Lock()
+	defer rm.mu.Unlock()
+
+	rm.emotes = packs
+}
+
+// EmotePack returns the emotes of the named pack, if new rooms may choose it.
+func (rm *RoomManager) EmotePack(name string) ([]string, bool) {
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+
+	emotes, ok := rm.emotes.Pack(name)
+	return slices.Clone(emotes), ok
+}
+
+// emotePackLocked returns a copy of the emotes of the named pack, falling
+// back to the default pack if it no longer exists, for a room to keep. The
+// caller must hold rm.mu.
+func (rm *RoomManager) emotePackLocked(name string) []string {
+	if emotes, ok := rm.emotes.Pack(name); ok {
+		return slices.Clone(emotes)
+	}
+
+	slog.Warn("unknown emote pack, using the default", "pack", name)
+	emotes, _ := rm.emotes.Pack(defaultEmotePack)
+	return slices.Clone(emotes)
+}
+
+// CreateRoom creates a new room with a unique code that plays by rules.
+func (rm *RoomManager) CreateRoom(rules Rules) (*Room, error) {
 	rm.mu.Lock()
 	defer rm.mu.Unlock()
 
@@ -293,7 +609,14 @@ func (rm *RoomManager) CreateRoom() (*Room, error) {
 			return nil, fmt.Errorf("generating room code: %w", err)
 		}
 		if _, exists := rm.rooms[code]; !exists {
-			room := &Room{Code: code}
+			room := newRoom(code, rules)
+			room.replays = rm.replays
+			room.store = rm.store
+			room.emotes = rm.emotePackLocked(rules.Emotes)
+			if rules.Daily {
+				room.daily = rm.daily
+			}
+
 			rm.rooms[code] = room
 			slog.Info("room created", "code", code)
 			return room, nil
@@ -311,12 +634,31 @@ func (rm *RoomManager) GetRoom(code string) *Room {
 	return rm.rooms[code]
 }
 
-// RemoveRoom removes a room by its code.
+// RemoveRoom removes a room by its code and stops any bots seated in it.
 func (rm *RoomManager) RemoveRoom(code string) {
 	rm.mu.Lock()
-	defer rm.mu.Unlock()
-
+	room := rm.rooms[code]
 	delete(rm.rooms, code)
+	rm.mu.Unlock()
+
+	if room != nil {
+		room.mu.Lock()
+		room.archiveLocked()
+		room.closed = true
+		if room.turnTimer != nil {
+			room.turnTimer.Stop()
+		}
+
+		if room.store != nil {
+			if err := room.store.Delete(code); err != nil {
+				slog.Error("failed to delete room snapshot", "room", code, "error", err)
+			}
+		}
+		room.mu.Unlock()
+
+		room.stopBots()
+	}
+
 	slog.Info("room removed", "code", code)
 }
 
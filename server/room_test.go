@@ -40,7 +40,7 @@ func TestGenerateRoomCodeUniqueness(t *testing.T) {
 func TestRoomManagerCreateAndGet(t *testing.T) {
 	rm := NewRoomManager()
 
-	room, err := rm.CreateRoom()
+	room, err := rm.CreateRoom(DefaultRules())
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -66,7 +66,7 @@ func TestRoomManagerGetNotFound(t *testing.T) {
 
 func TestRoomManagerRemoveRoom(t *testing.T) {
 	rm := NewRoomManager()
-	room, err := rm.CreateRoom()
+	room, err := rm.CreateRoom(DefaultRules())
 
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
@@ -81,7 +81,7 @@ func TestRoomManagerRemoveRoom(t *testing.T) {
 }
 
 func TestRoomAddPlayer(t *testing.T) {
-	room := &Room{Code: "TEST"}
+	room := newRoom("TEST", DefaultRules())
 	c1 := &Client{name: "Alice"}
 	c2 := &Client{name: "Bob"}
 	c3 := &Client{name: "Charlie"}
@@ -108,8 +108,62 @@ func TestRoomAddPlayer(t *testing.T) {
 	}
 }
 
+func TestJoinRoomWithDisconnectedSeat(t *testing.T) {
+	t.Run("lobby", func(t *testing.T) {
+		rules := DefaultRules()
+		rules.Players = 3
+		rm := NewRoomManager()
+		room, err := rm.CreateRoom(rules)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		alice, bob, eve := newTestClient(rm), newTestClient(rm), newTestClient(rm)
+		sendTestMsg(t, alice, JoinRoomMsg{Type: "join_room", Name: "Alice", RoomCode: room.Code})
+		sendTestMsg(t, bob, JoinRoomMsg{Type: "join_room", Name: "Bob", RoomCode: room.Code})
+		room.DisconnectPlayer(bob, rm)
+
+		sendTestMsg(t, eve, JoinRoomMsg{Type: "join_room", Name: "Bob", RoomCode: room.Code})
+		if eve.room != nil {
+			t.Fatal("expected the disconnected player's name to stay taken")
+		}
+
+		sendTestMsg(t, eve, JoinRoomMsg{Type: "join_room", Name: "Eve", RoomCode: room.Code})
+		if eve.playerNumber != 3 {
+			t.Errorf("expected Eve in seat 3, got %d", eve.playerNumber)
+		}
+
+		if room.Disconnected[1] == nil {
+			t.Error("expected Bob's seat to be held")
+		}
+	})
+
+	t.Run("game in progress", func(t *testing.T) {
+		rm := NewRoomManager()
+		room, err := rm.CreateRoom(DefaultRules())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		alice, bob, eve := newTestClient(rm), newTestClient(rm), newTestClient(rm)
+		sendTestMsg(t, alice, JoinRoomMsg{Type: "join_room", Name: "Alice", RoomCode: room.Code})
+		sendTestMsg(t, bob, JoinRoomMsg{Type: "join_room", Name: "Bob", RoomCode: room.Code})
+		token := room.tokens[1]
+		room.DisconnectPlayer(bob, rm)
+
+		sendTestMsg(t, eve, JoinRoomMsg{Type: "join_room", Name: "Eve", RoomCode: room.Code})
+		if got := drainTypes(t, eve); eve.room != nil || len(got) != 1 || got[0] != "error" {
+			t.Fatalf("expected Eve to be refused, got %v", got)
+		}
+
+		if _, ok := room.ReconnectPlayer(newTestClient(rm), "Bob", token); !ok {
+			t.Error("expected Bob to be able to reconnect")
+		}
+	})
+}
+
 func TestRoomRemovePlayer(t *testing.T) {
-	room := &Room{Code: "TEST"}
+	room := newRoom("TEST", DefaultRules())
 	c1 := &Client{name: "Alice"}
 	c2 := &Client{name: "Bob"}
 
@@ -127,28 +181,85 @@ func TestRoomRemovePlayer(t *testing.T) {
 	}
 }
 
-func TestRoomPartner(t *testing.T) {
-	room := &Room{Code: "TEST"}
+func TestRoomPartners(t *testing.T) {
+	rules := DefaultRules()
+	rules.Players = 3
+	room := newRoom("TEST", rules)
 	c1 := &Client{name: "Alice"}
 	c2 := &Client{name: "Bob"}
+	c3 := &Client{name: "Carol"}
 
 	room.AddPlayer(c1, "Alice")
 
-	opp := room.Partner(c1)
-	if opp != nil {
-		t.Error("expected no partner when only one player")
+	if partners := room.Partners(c1); len(partners) != 0 {
+		t.Errorf("expected no partners when only one player, got %d", len(partners))
 	}
 
 	room.AddPlayer(c2, "Bob")
+	room.AddPlayer(c3, "Carol")
+
+	partners := room.Partners(c2)
+	if len(partners) != 2 || partners[0] != c1 || partners[1] != c3 {
+		t.Error("expected c1 and c3 as partners of c2")
+	}
+}
+
+func TestRoomAddPlayerFourSeats(t *testing.T) {
+	rules := DefaultRules()
+	rules.Players = 4
+	room := newRoom("TEST", rules)
+
+	for i, name := range []string{"A", "B", "C", "D"} {
+		num, err := room.AddPlayer(&Client{name: name}, name)
+		if err != nil {
+			t.Fatalf("unexpected error adding player %d: %v", i+1, err)
+		}
+
+		if num != i+1 {
+			t.Errorf("expected player number %d, got %d", i+1, num)
+		}
+	}
+
+	if _, err := room.AddPlayer(&Client{name: "E"}, "E"); err == nil {
+		t.Error("expected error when adding fifth player to full room")
+	}
+}
+
+func TestRoomReconnectRequiresToken(t *testing.T) {
+	room := newRoom("TEST", DefaultRules())
+	rm := NewRoomManager()
+	alice := &Client{name: "Alice"}
+
+	num, _ := room.AddPlayer(alice, "Alice")
+	token := room.SessionToken(num)
+	if token == "" {
+		t.Fatal("expected a session token for a seated player")
+	}
+
+	room.DisconnectPlayer(alice, rm)
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{"missing token", ""},
+		{"wrong token", token + "x"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := room.ReconnectPlayer(&Client{}, "Alice", tt.token); ok {
+				t.Error("expected reconnect to be refused")
+			}
+		})
+	}
 
-	opp = room.Partner(c1)
-	if opp != c2 {
-		t.Error("expected c2 as partner of c1")
+	if _, ok := room.ReconnectPlayer(&Client{}, "Alice", token); !ok {
+		t.Fatal("expected reconnect with the session token to succeed")
 	}
 
-	opp = room.Partner(c2)
-	if opp != c1 {
-		t.Error("expected c1 as partner of c2")
+	if rotated := room.SessionToken(num); rotated == token || rotated == "" {
+		t.Error("expected the session token to rotate after reconnecting")
 	}
 }
 
@@ -0,0 +1,105 @@
+package main
+
+import "fmt"
+
+// Rule limits accepted from room creators.
+const (
+	minPlayers        = 2
+	maxPlayers        = 4
+	maxBoardSize      = 30
+	maxPasses         = 3
+	maxSwapsPerPlayer = 3
+	maxSwapRounds     = 3
+	maxSignals        = 5
+	minTurnSeconds    = 10
+	maxTurnSeconds    = 600
+)
+
+// Suit order variants.
+const (
+	SuitOrderFixed   = "fixed"   // the deck's suit order, known to everyone
+	SuitOrderPartial = "partial" // shuffled per game; each player is told one pair
+	SuitOrderHidden  = "hidden"  // shuffled per game; revealed only at the end
+)
+
+// Rules are the per-room game settings chosen by the room creator.
+type Rules struct {
+	Players        int     `json:"players"`        // seats in the room
+	HandSize       int     `json:"handSize"`       // cards dealt to each player
+	BoardSize      int     `json:"boardSize"`      // slots on the board
+	Passes         int     `json:"passes"`         // passes each player may use
+	SwapsPerPlayer int     `json:"swapsPerPlayer"` // accepted swaps each player may make
+	SwapRounds     int     `json:"swapRounds"`     // swap-phase turns per player before the reveal
+	Deck           DeckDef `json:"deck"`           // cards dealt from, in sort order
+	SuitOrder      string  `json:"suitOrder"`      // one of the SuitOrder variants
+	Daily          bool    `json:"daily"`          // deal the daily challenge; see DailyRules
+	TurnSeconds    int     `json:"turnSeconds"`    // time limit per turn; 0 for no limit
+	Signals        int     `json:"signals"`        // signal tokens each player may spend
+	Emotes         string  `json:"emotes"`         // emote pack name; "" for the default pack
+}
+
+// DefaultRules returns the standard rules: two players with 7 cards each from
+// the standard deck on a 15-slot board, one pass and one accepted swap per
+// player, and one swap-phase turn each.
+func DefaultRules() Rules {
+	return Rules{
+		Players:        2,
+		HandSize:       7,
+		BoardSize:      15,
+		Passes:         1,
+		SwapsPerPlayer: 1,
+		SwapRounds:     1,
+		Deck:           DefaultDeck(),
+		SuitOrder:      SuitOrderFixed,
+	}
+}
+
+// SecretSuitOrder reports whether each game shuffles the suit order.
+func (r Rules) SecretSuitOrder() bool {
+	return r.SuitOrder != SuitOrderFixed
+}
+
+// SwapTurns returns the total number of swap-phase turns before the reveal.
+func (r Rules) SwapTurns() int {
+	return r.SwapRounds * r.Players
+}
+
+// Validate reports whether the rules describe a playable game.
+func (r Rules) Validate() error {
+	if err := r.Deck.Validate(); err != nil {
+		return err
+	}
+
+	deckSize := r.Deck.Size()
+
+	switch {
+	case r.Players < minPlayers || r.Players > maxPlayers:
+		return fmt.Errorf("players must be between %d and %d", minPlayers, maxPlayers)
+	case r.HandSize < 1:
+		return fmt.Errorf("hand size must be at least 1")
+	case r.HandSize*r.Players > deckSize:
+		return fmt.Errorf("hand size must be at most %d for %d players", deckSize/r.Players, r.Players)
+	case r.BoardSize < r.HandSize*r.Players:
+		return fmt.Errorf("board size must fit all %d cards", r.HandSize*r.Players)
+	case r.BoardSize > maxBoardSize:
+		return fmt.Errorf("board size must be at most %d", maxBoardSize)
+	case r.Passes < 0 || r.Passes > maxPasses:
+		return fmt.Errorf("passes must be between 0 and %d", maxPasses)
+	case r.SwapsPerPlayer < 0 || r.SwapsPerPlayer > maxSwapsPerPlayer:
+		return fmt.Errorf("swaps per player must be between 0 and %d", maxSwapsPerPlayer)
+	case r.SwapRounds < 0 || r.SwapRounds > maxSwapRounds:
+		return fmt.Errorf("swap rounds must be between 0 and %d", maxSwapRounds)
+	case r.Signals < 0 || r.Signals > maxSignals:
+		return fmt.Errorf("signals must be between 0 and %d", maxSignals)
+	case r.TurnSeconds != 0 && (r.TurnSeconds < minTurnSeconds || r.TurnSeconds > maxTurnSeconds):
+		return fmt.Errorf("turn seconds must be 0 or between %d and %d", minTurnSeconds, maxTurnSeconds)
+	}
+
+	switch r.SuitOrder {
+	case SuitOrderFixed, SuitOrderPartial, SuitOrderHidden:
+	default:
+		return fmt.Errorf("suit order must be %q, %q or %q", SuitOrderFixed, SuitOrderPartial, SuitOrderHidden)
+	}
+
+	return nil
+}
@@ -0,0 +1,143 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestRulesValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(r *Rules)
+		valid  bool
+	}{
+		{"defaults", func(r *Rules) {}, true},
+		{"small board game", func(r *Rules) { r.HandSize, r.BoardSize = 3, 6 }, true},
+		{"zero hand", func(r *Rules) { r.HandSize = 0 }, false},
+		{"hands exceed deck", func(r *Rules) { r.HandSize, r.BoardSize = 21, 30 }, false},
+		{"board too small", func(r *Rules) { r.BoardSize = 13 }, false},
+		{"board too large", func(r *Rules) { r.BoardSize = maxBoardSize + 1 }, false},
+		{"no passes", func(r *Rules) { r.Passes = 0 }, true},
+		{"negative passes", func(r *Rules) { r.Passes = -1 }, false},
+		{"too many swaps", func(r *Rules) { r.SwapsPerPlayer = maxSwapsPerPlayer + 1 }, false},
+		{"no swap phase", func(r *Rules) { r.SwapRounds = 0 }, true},
+		{"too many swap rounds", func(r *Rules) { r.SwapRounds = maxSwapRounds + 1 }, false},
+		{"four players", func(r *Rules) { r.Players, r.BoardSize = 4, 30 }, true},
+		{"four players on a small board", func(r *Rules) { r.Players = 4 }, false},
+		{"one player", func(r *Rules) { r.Players = 1 }, false},
+		{"too many players", func(r *Rules) { r.Players = maxPlayers + 1 }, false},
+		{"kids deck", func(r *Rules) { r.Deck.Suits = r.Deck.Suits[:2] }, true},
+		{"hands exceed kids deck", func(r *Rules) { r.Deck.Suits, r.HandSize = r.Deck.Suits[:1], 6 }, false},
+		{"invalid deck", func(r *Rules) { r.Deck.MinValue = 0 }, false},
+		{"hidden suit order", func(r *Rules) { r.SuitOrder = SuitOrderHidden }, true},
+		{"unknown suit order", func(r *Rules) { r.SuitOrder = "random" }, false},
+		{"signals", func(r *Rules) { r.Signals = maxSignals }, true},
+		{"too many signals", func(r *Rules) { r.Signals = maxSignals + 1 }, false},
+		{"turn timer", func(r *Rules) { r.TurnSeconds = 30 }, true},
+		{"turn timer too short", func(r *Rules) { r.TurnSeconds = minTurnSeconds - 1 }, false},
+		{"turn timer too long", func(r *Rules) { r.TurnSeconds = maxTurnSeconds + 1 }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := DefaultRules()
+			tt.modify(&r)
+
+			if err := r.Validate(); (err == nil) != tt.valid {
+				t.Errorf("Validate() = %v, want valid %v", err, tt.valid)
+			}
+		})
+	}
+}
+
+func TestCreateRoomRulesDefaults(t *testing.T) {
+	msg := CreateRoomMsg{Rules: DefaultRules()}
+	raw := `{"type":"create_room","name":"Alice","rules":{"passes":2,"swapRounds":0,"deck":{"suits":["C","H"]}}}`
+
+	if err := json.Unmarshal([]byte(raw), &msg); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := DefaultRules()
+	want.Passes = 2
+	want.SwapRounds = 0
+	want.Deck.Suits = []Suit{Clubs, Hearts}
+
+	if !reflect.DeepEqual(msg.Rules, want) {
+		t.Errorf("rules = %+v, want %+v", msg.Rules, want)
+	}
+}
+
+func TestGameEnforcesRules(t *testing.T) {
+	t.Run("multiple passes", func(t *testing.T) {
+		g := newTestGame()
+		g.Rules.Passes = 2
+
+		if err := g.UsePass(1); err != nil {
+			t.Fatalf("first pass: %v", err)
+		}
+
+		g.PlaceCard(2, 0, 0)
+		if err := g.UsePass(1); err != nil {
+			t.Fatalf("second pass: %v", err)
+		}
+
+		g.PlaceCard(2, 1, 1)
+		if err := g.UsePass(1); err == nil {
+			t.Error("expected error for third pass")
+		}
+	})
+
+	t.Run("no swap turns goes straight to reveal", func(t *testing.T) {
+		g := newTestGame()
+		g.Rules.SwapRounds = 0
+
+		for i := 0; i < 7; i++ {
+			g.PlaceCard(1, i, i*2)
+			g.PlaceCard(2, i, i*2+1)
+		}
+
+		if g.Phase != PhaseReveal {
+			t.Errorf("expected reveal phase, got %s", g.Phase)
+		}
+	})
+
+	t.Run("multiple swaps per player", func(t *testing.T) {
+		g := newSwapTestGame()
+		g.Rules.SwapsPerPlayer = 2
+		g.Rules.SwapRounds = 2
+
+		g.SuggestSwap(1, 0, 1)
+		g.RespondSwap(2, true)
+		g.SkipSwap(2)
+
+		if err := g.SuggestSwap(1, 2, 3); err != nil {
+			t.Errorf("expected second swap to be allowed: %v", err)
+		}
+	})
+
+	t.Run("custom hand and board size", func(t *testing.T) {
+		rules := DefaultRules()
+		rules.HandSize, rules.BoardSize = 3, 8
+
+		g, err := NewGame(rules)
+		if err != nil {
+			t.Fatalf("NewGame error: %v", err)
+		}
+
+		if len(g.Hands[0]) != 3 || len(g.Hands[1]) != 3 || len(g.Board) != 8 {
+			t.Errorf("expected 3-card hands on 8 slots, got %d/%d on %d",
+				len(g.Hands[0]), len(g.Hands[1]), len(g.Board))
+		}
+
+		g.StartPlacement(1)
+		if err := g.PlaceCard(1, 3, 0); err == nil {
+			t.Error("expected error for card index beyond hand size")
+		}
+
+		if err := g.PlaceCard(1, 0, 8); err == nil {
+			t.Error("expected error for slot beyond board size")
+		}
+	})
+}
@@ -0,0 +1,60 @@
+package main
+
+// Scoring weights. A perfectly ordered board with no passes or swaps used
+// scores the maximum: every card in the run plus all bonuses.
+const (
+	pointsPerRunCard    = 10 // per card in the longest increasing run
+	penaltyPerInversion = 5  // per pair of cards in the wrong relative order
+	bonusUnusedPass     = 5  // per pass left unused
+	bonusUnusedSwap     = 5  // per allowed swap left unused
+)
+
+// ScoreBreakdown is a graded game result. Total never drops below zero.
+type ScoreBreakdown struct {
+	Inversions       int `json:"inversions"` // pairs of cards in the wrong relative order
+	LongestRun       int `json:"longestRun"` // longest stretch of consecutive revealed cards in increasing order
+	UnusedPasses     int `json:"unusedPasses"`
+	UnusedSwaps      int `json:"unusedSwaps"`
+	RunPoints        int `json:"runPoints"`
+	InversionPenalty int `json:"inversionPenalty"`
+	PassBonus        int `json:"passBonus"`
+	SwapBonus        int `json:"swapBonus"`
+	Total            int `json:"total"`
+}
+
+// Score computes the graded score of the board in reveal order.
+func (g *Game) Score() ScoreBreakdown {
+	order := g.RevealOrder()
+	var s ScoreBreakdown
+
+	run := 0
+	for i, entry := range order {
+		idx := entry.Card.SortIndex(g.Deck)
+		for _, later := range order[i+1:] {
+			if later.Card.SortIndex(g.Deck) < idx {
+				s.Inversions++
+			}
+		}
+
+		if i > 0 && order[i-1].Card.SortIndex(g.Deck) < idx {
+			run++
+		} else {
+			run = 1
+		}
+
+		s.LongestRun = max(s.LongestRun, run)
+	}
+
+	for i := range g.PassesUsed {
+		s.UnusedPasses += g.Rules.Passes - g.PassesUsed[i]
+		s.UnusedSwaps += g.Rules.SwapsPerPlayer - g.SwapsAccepted[i]
+	}
+
+	s.RunPoints = s.LongestRun * pointsPerRunCard
+	s.InversionPenalty = s.Inversions * penaltyPerInversion
+	s.PassBonus = s.UnusedPasses * bonusUnusedPass
+	s.SwapBonus = s.UnusedSwaps * bonusUnusedSwap
+	s.Total = max(0, s.RunPoints-s.InversionPenalty+s.PassBonus+s.SwapBonus)
+
+	return s
+}
@@ -0,0 +1,66 @@
+package main
+
+import "testing"
+
+func TestScore(t *testing.T) {
+	tests := []struct {
+		name           string
+		cards          map[int]Card
+		passesUsed     []int
+		swapsAccepted  []int
+		wantInversions int
+		wantRun        int
+		wantTotal      int
+	}{
+		{
+			name:      "ordered board with nothing used",
+			cards:     map[int]Card{0: {Hearts, 1}, 3: {Spades, 2}, 7: {Clubs, 10}},
+			wantRun:   3,
+			wantTotal: 3*pointsPerRunCard + 2*bonusUnusedPass + 2*bonusUnusedSwap,
+		},
+		{
+			name:           "one card out of place",
+			cards:          map[int]Card{0: {Hearts, 1}, 1: {Clubs, 1}, 2: {Spades, 1}, 3: {Diamonds, 1}},
+			passesUsed:     []int{1, 1},
+			swapsAccepted:  []int{1, 0},
+			wantInversions: 2,
+			wantRun:        2,
+			wantTotal:      2*pointsPerRunCard - 2*penaltyPerInversion + bonusUnusedSwap,
+		},
+		{
+			name:           "reversed board floors at zero",
+			cards:          map[int]Card{0: {Clubs, 4}, 1: {Clubs, 3}, 2: {Clubs, 2}, 3: {Clubs, 1}},
+			passesUsed:     []int{1, 1},
+			swapsAccepted:  []int{1, 1},
+			wantInversions: 6,
+			wantRun:        1,
+			wantTotal:      0,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := boardGame(tt.cards)
+			if tt.passesUsed != nil {
+				g.PassesUsed = tt.passesUsed
+			}
+
+			if tt.swapsAccepted != nil {
+				g.SwapsAccepted = tt.swapsAccepted
+			}
+
+			s := g.Score()
+			if s.Inversions != tt.wantInversions {
+				t.Errorf("inversions = %d, want %d", s.Inversions, tt.wantInversions)
+			}
+
+			if s.LongestRun != tt.wantRun {
+				t.Errorf("longest run = %d, want %d", s.LongestRun, tt.wantRun)
+			}
+
+			if s.Total != tt.wantTotal {
+				t.Errorf("total = %d, want %d", s.Total, tt.wantTotal)
+			}
+		})
+	}
+}
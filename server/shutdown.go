@@ -0,0 +1,93 @@
+package main
+
+import (
+	"context"
+	"time"
+)
+
+// drainPoll is how often a draining server checks for games still in play.
+const drainPoll = 500 * time.Millisecond
+
+// Drain starts shutting the server down: no new rooms are created and no new
+// games started, and every client is sent msg, including clients that
+// connect from now on.
+func (rm *RoomManager) Drain(msg ServerShutdownMsg) {
+	rm.mu.Lock()
+	rm.shutdown = &msg
+	rm.mu.Unlock()
+
+	rm.Notify(msg)
+}
+
+// Draining reports whether the server is shutting down.
+func (rm *RoomManager) Draining() bool {
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+
+	return rm.shutdown != nil
+}
+
+// ActiveGames counts the games dealt but not yet over.
+func (rm *RoomManager) ActiveGames() int {
+	active := 0
+	for phase, rooms := range rm.RoomsByPhase() {
+		if phase != PhaseLobby && phase != PhaseGameOver {
+			active += rooms
+		}
+	}
+
+	return active
+}
+
+// WaitForGames waits until no game is in progress. It returns false if ctx
+// ends first.
+func (rm *RoomManager) WaitForGames(ctx context.Context) bool {
+	ticker := time.NewTicker(drainPoll)
+	defer ticker.Stop()
+
+	for rm.ActiveGames() > 0 {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+
+	return true
+}
+
+// CloseConnections closes every client connection with a close code and
+// reason, after the messages already queued for it. Players keep their
+// seats for the grace period, or until the rooms are restored after a
+// restart.
+func (rm *RoomManager) CloseConnections(code int, reason string) {
+	rm.mu.RLock()
+	defer rm.mu.RUnlock()
+
+	for c := range rm.clients {
+		c.closeAfterQueued(code, reason)
+	}
+}
+
+// WaitForDisconnects waits until every client connection has closed. It
+// returns false if ctx ends first.
+func (rm *RoomManager) WaitForDisconnects(ctx context.Context) bool {
+	ticker := time.NewTicker(drainPoll / 10)
+	defer ticker.Stop()
+
+	for {
+		rm.mu.RLock()
+		connected := len(rm.clients)
+		rm.mu.RUnlock()
+
+		if connected == 0 {
+			return true
+		}
+
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+}
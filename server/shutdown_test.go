@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestDrain(t *testing.T) {
+	rm := NewRoomManager()
+	room, err := rm.CreateRoom(DefaultRules())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	alice, bob := newTestClient(rm), newTestClient(rm)
+	rm.Track(alice)
+	sendTestMsg(t, alice, JoinRoomMsg{Type: "join_room", Name: "Alice", RoomCode: room.Code})
+	sendTestMsg(t, bob, JoinRoomMsg{Type: "join_room", Name: "Bob", RoomCode: room.Code})
+	drainTypes(t, alice)
+
+	if got := rm.ActiveGames(); got != 1 {
+		t.Fatalf("expected 1 active game, got %d", got)
+	}
+
+	rm.Drain(ServerShutdownMsg{Type: "server_shutdown", Reason: "server restarting", DowntimeSeconds: 60})
+	if got := drainTypes(t, alice); len(got) != 1 || got[0] != "server_shutdown" {
+		t.Errorf("expected server_shutdown, got %v", got)
+	}
+
+	carol := newTestClient(rm)
+	rm.Track(carol)
+	if got := drainTypes(t, carol); len(got) != 1 || got[0] != "server_shutdown" {
+		t.Errorf("expected a late client to get server_shutdown, got %v", got)
+	}
+
+	sendTestMsg(t, carol, CreateRoomMsg{Type: "create_room", Name: "Carol", Rules: DefaultRules()})
+	if got := drainTypes(t, carol); len(got) != 1 || got[0] != "error" || carol.room != nil {
+		t.Errorf("expected new rooms to be refused, got %v", got)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if rm.WaitForGames(ctx) {
+		t.Error("expected the wait to time out with a game in progress")
+	}
+
+	room.mu.Lock()
+	room.Game.Phase = PhaseGameOver
+	room.mu.Unlock()
+
+	if !rm.WaitForGames(context.Background()) {
+		t.Error("expected the wait to end once the game is over")
+	}
+}
+
+func TestDrainStartsNoGames(t *testing.T) {
+	shutdown := ServerShutdownMsg{Type: "server_shutdown", Reason: "server shutting down"}
+
+	t.Run("join", func(t *testing.T) {
+		rm := NewRoomManager()
+		room, err := rm.CreateRoom(DefaultRules())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		alice, bob := newTestClient(rm), newTestClient(rm)
+		sendTestMsg(t, alice, JoinRoomMsg{Type: "join_room", Name: "Alice", RoomCode: room.Code})
+		rm.Drain(shutdown)
+
+		sendTestMsg(t, bob, JoinRoomMsg{Type: "join_room", Name: "Bob", RoomCode: room.Code})
+		if got := drainTypes(t, bob); bob.room != nil || len(got) != 1 || got[0] != "error" {
+			t.Errorf("expected Bob to be refused, got %v", got)
+		}
+
+		if room.GamePhase() != PhaseLobby {
+			t.Errorf("expected no game to start, got phase %s", room.GamePhase())
+		}
+	})
+
+	t.Run("play again", func(t *testing.T) {
+		rm := NewRoomManager()
+		room, err := rm.CreateRoom(DefaultRules())
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		alice, bob := newTestClient(rm), newTestClient(rm)
+		sendTestMsg(t, alice, JoinRoomMsg{Type: "join_room", Name: "Alice", RoomCode: room.Code})
+		sendTestMsg(t, bob, JoinRoomMsg{Type: "join_room", Name: "Bob", RoomCode: room.Code})
+
+		room.mu.Lock()
+		room.Game.Phase = PhaseGameOver
+		room.PlayAgainReady[1] = true
+		room.mu.Unlock()
+
+		rm.Drain(shutdown)
+		drainTypes(t, alice)
+		sendTestMsg(t, alice, Envelope{Type: "play_again"})
+		if got := drainTypes(t, alice); len(got) != 1 || got[0] != "error" {
+			t.Errorf("expected the rematch to be refused, got %v", got)
+		}
+
+		if room.GamePhase() != PhaseGameOver {
+			t.Errorf("expected no rematch to start, got phase %s", room.GamePhase())
+		}
+	})
+}
+
+func TestCloseConnections(t *testing.T) {
+	rm := NewRoomManager()
+	server := httptest.NewServer(handleWebSocket(rm))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer conn.Close()
+
+	rm.Drain(ServerShutdownMsg{Type: "server_shutdown", Reason: "server restarting"})
+	rm.CloseConnections(websocket.CloseServiceRestart, "server restarting")
+
+	conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+	var notice ServerShutdownMsg
+	if err := conn.ReadJSON(&notice); err != nil || notice.Type != "server_shutdown" {
+		t.Fatalf("expected server_shutdown, got %+v (%v)", notice, err)
+	}
+
+	if _, _, err := conn.ReadMessage(); !websocket.IsCloseError(err, websocket.CloseServiceRestart) {
+		t.Errorf("expected a service restart close, got %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if !rm.WaitForDisconnects(ctx) {
+		t.Error("expected every connection to close")
+	}
+}
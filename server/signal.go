@@ -0,0 +1,168 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+)
+
+// Signal kinds: the only hints a player may attach to one of their cards.
+const (
+	SignalLow           = "low"             // in the lower half of the deck
+	SignalHigh          = "high"            // in the upper half of the deck
+	SignalSameSuitLeft  = "same_suit_left"  // same suit as the card in the slot to the left
+	SignalSameSuitRight = "same_suit_right" // same suit as the card in the slot to the right
+)
+
+// ValidSignal reports whether kind is a known signal kind.
+func ValidSignal(kind string) bool {
+	switch kind {
+	case SignalLow, SignalHigh, SignalSameSuitLeft, SignalSameSuitRight:
+		return true
+	}
+
+	return false
+}
+
+// Signal is a hint a player attached to a board slot holding one of their
+// cards. It follows the card when a swap moves it.
+type Signal struct {
+	SlotIndex int    `json:"slotIndex"`
+	Kind      string `json:"kind"`
+	ByPlayer  int    `json:"byPlayer"`
+}
+
+// CanSignal reports whether a player has a signal token left.
+func (g *Game) CanSignal(playerNumber int) bool {
+	return g.Rules.Signals > 0 && g.SignalsUsed[playerNumber-1] < g.Rules.Signals
+}
+
+// SendSignal spends one of a player's signal tokens on a hint about the card
+// they own at slotIndex. Each slot holds at most one signal. Signals do not
+// use up a turn.
+func (g *Game) SendSignal(playerNumber, slotIndex int, kind string) error {
+	if g.Phase != PhasePlacement && g.Phase != PhaseSwap {
+		return fmt.Errorf("signals not allowed in this phase")
+	}
+
+	if !ValidSignal(kind) {
+		return fmt.Errorf("unknown signal")
+	}
+
+	if !g.CanSignal(playerNumber) {
+		return fmt.Errorf("you have no signals left")
+	}
+
+	if slotIndex < 0 || slotIndex >= len(g.Board) {
+		return fmt.Errorf("invalid slot index")
+	}
+
+	if g.BoardOwner[slotIndex] != playerNumber {
+		return fmt.Errorf("not your card")
+	}
+
+	if g.signalAt(slotIndex) >= 0 {
+		return fmt.Errorf("slot already has a signal")
+	}
+
+	if !g.signalHolds(playerNumber, *g.Board[slotIndex], kind) {
+		return fmt.Errorf("signal does not match the card")
+	}
+
+	g.SignalsUsed[playerNumber-1]++
+	g.Signals = append(g.Signals, Signal{SlotIndex: slotIndex, Kind: kind, ByPlayer: playerNumber})
+	g.record(ReplaySignal, playerNumber, SignalDetails{SlotIndex: slotIndex, Kind: kind})
+
+	return nil
+}
+
+// signalHolds reports whether a signal of kind is true of card as far as the
+// player knows. Low and high split the player's sort order in half, the
+// middle card of an odd-sized deck counting as low; checking them against a
+// secret suit order would leak it. The same-suit kinds are not checked: the
+// neighboring slot may still be empty.
+func (g *Game) signalHolds(playerNumber int, card Card, kind string) bool {
+	deck := g.deckFor(playerNumber)
+	low := 2*card.SortIndex(deck) < deck.Size()
+
+	switch kind {
+	case SignalLow:
+		return low
+	case SignalHigh:
+		return !low
+	}
+
+	return true
+}
+
+// signalAt returns the index in g.Signals of the signal on a slot, or -1.
+func (g *Game) signalAt(slotIndex int) int {
+	for i, s := range g.Signals {
+		if s.SlotIndex == slotIndex {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// swapSignals moves any signals on two slots along with their cards.
+func (g *Game) swapSignals(slotA, slotB int) {
+	for i, s := range g.Signals {
+		switch s.SlotIndex {
+		case slotA:
+			g.Signals[i].SlotIndex = slotB
+		case slotB:
+			g.Signals[i].SlotIndex = slotA
+		}
+	}
+}
+
+// removeSignal takes back the signal on a slot, if any, and returns the
+// token to the player who spent it.
+func (g *Game) removeSignal(slotIndex int) {
+	i := g.signalAt(slotIndex)
+	if i < 0 {
+		return
+	}
+
+	g.SignalsUsed[g.Signals[i].ByPlayer-1]--
+	g.Signals = append(g.Signals[:i], g.Signals[i+1:]...)
+}
+
+func (c *Client) handleSendSignal(raw []byte) {
+	var msg SendSignalMsg
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		c.SendMsg(newError("invalid send_signal message"))
+		return
+	}
+
+	if c.room == nil {
+		c.SendMsg(newError("no active game"))
+		return
+	}
+
+	c.room.mu.Lock()
+	game := c.room.Game
+	if game == nil {
+		c.room.mu.Unlock()
+		c.SendMsg(newError("no active game"))
+		return
+	}
+
+	if err := game.SendSignal(c.playerNumber, msg.SlotIndex, msg.Kind); err != nil {
+		c.room.mu.Unlock()
+		c.SendMsg(newError(err.Error()))
+		return
+	}
+
+	audience := c.room.audienceLocked()
+	c.room.mu.Unlock()
+
+	slog.Info("signal sent", "player", c.name, "slot", msg.SlotIndex, "kind", msg.Kind, "room", c.room.Code)
+
+	broadcast(audience, SignalSentMsg{
+		Type:   "signal_sent",
+		Signal: Signal{SlotIndex: msg.SlotIndex, Kind: msg.Kind, ByPlayer: c.playerNumber},
+	})
+}
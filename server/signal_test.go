@@ -0,0 +1,158 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSendSignal(t *testing.T) {
+	tests := []struct {
+		name    string
+		player  int
+		slot    int
+		kind    string
+		wantErr bool
+	}{
+		{"own card", 1, 0, SignalLow, false},
+		{"partner's card", 2, 0, SignalLow, true},
+		{"empty slot", 1, 3, SignalHigh, true},
+		{"out of range", 1, 15, SignalHigh, true},
+		{"unknown kind", 1, 0, "even", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newTestGame()
+			g.Rules.Signals = 1
+			g.PlaceCard(1, 0, 0)
+
+			if err := g.SendSignal(tt.player, tt.slot, tt.kind); (err != nil) != tt.wantErr {
+				t.Errorf("SendSignal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("tokens run out", func(t *testing.T) {
+		g := newTestGame()
+		g.Rules.Signals = 1
+		g.PlaceCard(1, 0, 0)
+		g.PlaceCard(2, 0, 1)
+		g.PlaceCard(1, 1, 2)
+
+		if err := g.SendSignal(1, 0, SignalLow); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if err := g.SendSignal(1, 2, SignalSameSuitLeft); err == nil {
+			t.Error("expected error without tokens left")
+		}
+
+		if last := g.Events[len(g.Events)-1]; last.Action != ReplaySignal || last.Player != 1 {
+			t.Errorf("expected the signal in the replay, got %+v", last)
+		}
+	})
+
+	t.Run("must be true", func(t *testing.T) {
+		g := newTestGame()
+		g.Rules.Signals = 2
+		g.Hands[0][6] = Card{Clubs, 7}
+		g.PlaceCard(1, 0, 0)
+		g.PlaceCard(2, 0, 1)
+		g.PlaceCard(1, 6, 2)
+
+		if err := g.SendSignal(1, 0, SignalHigh); err == nil {
+			t.Error("expected error calling a low card high")
+		}
+
+		if err := g.SendSignal(1, 2, SignalLow); err == nil {
+			t.Error("expected error calling a high card low")
+		}
+
+		if err := g.SendSignal(1, 2, SignalHigh); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+	})
+
+	t.Run("judged by the player's view", func(t *testing.T) {
+		g := newTestGame()
+		g.Rules.Signals = 2
+		g.Rules.SuitOrder = SuitOrderHidden
+		g.Deck.Suits = []Suit{Clubs, Diamonds, Spades, Hearts}
+		g.PlaceCard(1, 0, 0)
+
+		// Hearts are secretly the highest suit, but the player can't know that
+		if err := g.SendSignal(1, 0, SignalLow); err != nil {
+			t.Errorf("expected a low signal the player's view allows, got %v", err)
+		}
+
+		g.PlaceCard(2, 0, 1)
+		g.PlaceCard(1, 1, 2)
+		if err := g.SendSignal(1, 2, SignalHigh); err == nil {
+			t.Error("expected error calling a card high that the player's view puts low")
+		}
+	})
+
+	t.Run("off by default", func(t *testing.T) {
+		g := newTestGame()
+		g.PlaceCard(1, 0, 0)
+
+		if err := g.SendSignal(1, 0, SignalLow); err == nil {
+			t.Error("expected error without signal tokens")
+		}
+	})
+
+	t.Run("one per slot", func(t *testing.T) {
+		g := newTestGame()
+		g.Rules.Signals = 2
+		g.PlaceCard(1, 0, 0)
+		g.SendSignal(1, 0, SignalLow)
+
+		if err := g.SendSignal(1, 0, SignalSameSuitRight); err == nil {
+			t.Error("expected error signaling the same slot twice")
+		}
+	})
+}
+
+func TestSignalsFollowCards(t *testing.T) {
+	t.Run("swap", func(t *testing.T) {
+		g := newTestGame()
+		g.Rules.Signals = 1
+		g.PlaceCard(1, 0, 0)
+		g.PlaceCard(2, 0, 1)
+		g.SendSignal(1, 0, SignalLow)
+		g.SuggestSwap(2, 0, 1)
+		g.RespondSwap(1, true)
+
+		want := []Signal{{SlotIndex: 1, Kind: SignalLow, ByPlayer: 1}}
+		if !reflect.DeepEqual(g.Signals, want) {
+			t.Errorf("signals = %+v, want %+v", g.Signals, want)
+		}
+	})
+
+	t.Run("undo returns the token", func(t *testing.T) {
+		g := newTestGame()
+		g.Rules.Signals = 1
+		g.PlaceCard(1, 0, 0)
+		g.SendSignal(1, 0, SignalLow)
+
+		if err := g.RequestUndo(1); err != nil {
+			t.Fatalf("expected a signal not to block an undo: %v", err)
+		}
+
+		g.RespondUndo(2, true)
+		if len(g.Signals) != 0 || !g.CanSignal(1) {
+			t.Errorf("expected the signal taken back, got %+v", g.Signals)
+		}
+	})
+
+	t.Run("result", func(t *testing.T) {
+		g := newTestGame()
+		g.Rules.Signals = 1
+		g.PlaceCard(1, 0, 0)
+		g.SendSignal(1, 0, SignalLow)
+
+		if got := outcomeOf(g).resultMsg().Signals; len(got) != 1 || got[0].Kind != SignalLow {
+			t.Errorf("expected the signal in the result, got %+v", got)
+		}
+	})
+}
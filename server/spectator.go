@@ -0,0 +1,51 @@
+package main
+
+import "log/slog"
+
+// spectatorMessages are the only client messages accepted from spectators.
+var spectatorMessages = map[string]bool{
+	"exit_game":     true,
+	"request_state": true,
+	"echo":          true,
+}
+
+// joinAsSpectator lets the client watch room and sends it the public state.
+func (c *Client) joinAsSpectator(room *Room, name string) {
+	if err := room.AddSpectator(c); err != nil {
+		c.SendMsg(newError(err.Error()))
+		return
+	}
+
+	c.name = name
+	c.room = room
+	c.spectator = true
+
+	slog.Info("spectator joined room", "spectator", c.name, "room", room.Code)
+
+	room.mu.Lock()
+	c.SendMsg(room.spectatorStateLocked())
+	room.mu.Unlock()
+}
+
+// spectatorStateLocked builds the public view of the room for spectators.
+// The caller must hold r.mu.
+func (r *Room) spectatorStateLocked() SpectatorStateMsg {
+	return SpectatorStateMsg{Type: "spectator_state", PublicGameState: r.publicStateLocked()}
+}
+
+// sendSpectatorState sends every spectator a fresh public view of the room,
+// for changes they cannot follow from broadcast events, such as seats
+// filling or a new game being dealt.
+func (r *Room) sendSpectatorState() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(r.Spectators) == 0 {
+		return
+	}
+
+	state := r.spectatorStateLocked()
+	for _, s := range r.Spectators {
+		s.SendMsg(state)
+	}
+}
@@ -0,0 +1,134 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+// newTestClient creates a connectionless client whose messages can be read
+// back with drainTypes.
+func newTestClient(rm *RoomManager) *Client {
+	c := &Client{rooms: rm, send: make(chan []byte, 64)}
+	c.out = newOutbox(c)
+
+	return c
+}
+
+// drainTypes returns the types of the messages queued for c, oldest first.
+func drainTypes(t *testing.T, c *Client) []string {
+	t.Helper()
+
+	var types []string
+	for {
+		select {
+		case raw := <-c.send:
+			var env Envelope
+			if err := json.Unmarshal(raw, &env); err != nil {
+				t.Fatalf("invalid message: %v", err)
+			}
+			types = append(types, env.Type)
+		default:
+			return types
+		}
+	}
+}
+
+// sendTestMsg marshals msg and handles it as if c had sent it.
+func sendTestMsg(t *testing.T, c *Client, msg any) {
+	t.Helper()
+
+	raw, err := json.Marshal(msg)
+	if err != nil {
+		t.Fatalf("failed to marshal message: %v", err)
+	}
+
+	c.handleMessage(raw)
+}
+
+func TestRoomSpectators(t *testing.T) {
+	room := newRoom("TEST", DefaultRules())
+	alice := &Client{name: "Alice"}
+	watcher := &Client{name: "Watcher", spectator: true}
+
+	room.AddPlayer(alice, "Alice")
+	if err := room.AddSpectator(watcher); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if partners := room.Partners(alice); len(partners) != 0 {
+		t.Errorf("expected spectators to be excluded from partners, got %d", len(partners))
+	}
+
+	if others := room.Others(alice); len(others) != 1 || others[0] != watcher.out {
+		t.Error("expected the spectator among the others")
+	}
+
+	room.RemovePlayer(alice)
+	if !room.IsEmpty() {
+		t.Error("expected spectators not to keep a room alive")
+	}
+
+	for i := 1; i < maxSpectators; i++ {
+		if err := room.AddSpectator(&Client{}); err != nil {
+			t.Fatalf("unexpected error adding spectator %d: %v", i+1, err)
+		}
+	}
+
+	if err := room.AddSpectator(&Client{}); err == nil {
+		t.Error("expected error past the spectator limit")
+	}
+
+	room.RemoveSpectator(watcher)
+	if len(room.Spectators) != maxSpectators-1 {
+		t.Errorf("expected %d spectators after removal, got %d", maxSpectators-1, len(room.Spectators))
+	}
+}
+
+func TestSpectatorNeverSeesHands(t *testing.T) {
+	rm := NewRoomManager()
+	room, err := rm.CreateRoom(DefaultRules())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	alice, bob, watcher := newTestClient(rm), newTestClient(rm), newTestClient(rm)
+
+	sendTestMsg(t, alice, JoinRoomMsg{Type: "join_room", Name: "Alice", RoomCode: room.Code})
+	sendTestMsg(t, watcher, JoinRoomMsg{Type: "join_room", Name: "Watcher", RoomCode: room.Code, Spectate: true})
+	sendTestMsg(t, bob, JoinRoomMsg{Type: "join_room", Name: "Bob", RoomCode: room.Code})
+	sendTestMsg(t, alice, TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(PrefFirst)})
+	sendTestMsg(t, bob, TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(PrefNoFirst)})
+	sendTestMsg(t, alice, PlaceCardMsg{Type: "place_card", CardIndex: 0, SlotIndex: 0})
+	sendTestMsg(t, alice, PeekMsg{Type: "peek", SlotIndex: 0})
+
+	got := drainTypes(t, watcher)
+	seen := make(map[string]int)
+	for _, typ := range got {
+		seen[typ]++
+	}
+
+	for _, private := range []string{"turn_order_prompt", "game_start", "your_turn", "peek_result", "player_joined"} {
+		if seen[private] > 0 {
+			t.Errorf("spectator received %s: %v", private, got)
+		}
+	}
+
+	for _, public := range []string{"spectator_state", "turn_order_result", "card_placed"} {
+		if seen[public] == 0 {
+			t.Errorf("spectator missed %s: %v", public, got)
+		}
+	}
+
+	sendTestMsg(t, watcher, PlaceCardMsg{Type: "place_card", CardIndex: 0, SlotIndex: 1})
+	if got := drainTypes(t, watcher); len(got) != 1 || got[0] != "error" {
+		t.Errorf("expected an error for a spectator move, got %v", got)
+	}
+
+	room.mu.Lock()
+	state := room.spectatorStateLocked()
+	room.mu.Unlock()
+
+	if state.Phase != PhasePlacement || state.BoardOwner[0] != 1 || state.CurrentTurn != 2 {
+		t.Errorf("unexpected spectator state: %+v", state)
+	}
+}
@@ -0,0 +1,89 @@
+package main
+
+// publicStateLocked builds the part of the room's state everyone may see.
+// The caller must hold r.mu.
+func (r *Room) publicStateLocked() PublicGameState {
+	state := PublicGameState{
+		Players:        r.namesLocked(),
+		Rules:          r.Rules,
+		Phase:          PhaseLobby,
+		PlayAgainReady: append([]bool(nil), r.PlayAgainReady...),
+		Chat:           append([]ChatEntry(nil), r.chat...),
+	}
+
+	game := r.Game
+	if game == nil {
+		return state
+	}
+
+	state.Phase = game.Phase
+	state.FirstPlayer = game.FirstPlayer
+	state.CurrentTurn = game.CurrentTurn
+	state.BoardOwner = append([]int(nil), game.BoardOwner...)
+	state.PassesUsed = append([]int(nil), game.PassesUsed...)
+	state.SwapsAccepted = append([]int(nil), game.SwapsAccepted...)
+	state.SwapHistory = append([]SwapRecord(nil), game.SwapHistory...)
+	state.Signals = append([]Signal(nil), game.Signals...)
+	state.SignalsUsed = append([]int(nil), game.SignalsUsed...)
+	state.Deadline = deadlineMillis(game.TurnDeadline())
+
+	if game.SwapPending {
+		state.SwapPending = &SwapSuggestedMsg{
+			Type:     "swap_suggested",
+			SlotA:    game.SwapSlots[0],
+			SlotB:    game.SwapSlots[1],
+			ByPlayer: game.SwapSuggester,
+			Deadline: state.Deadline,
+		}
+	}
+
+	if placed, requester, ok := game.PendingUndo(); ok {
+		state.UndoPending = &UndoRequestedMsg{
+			Type:      "undo_requested",
+			SlotIndex: placed.SlotIndex,
+			ByPlayer:  requester,
+			Deadline:  state.Deadline,
+		}
+	}
+
+	if game.Phase == PhaseGameOver {
+		outcome := outcomeOf(game)
+		result := outcome.resultMsg()
+		state.Result = &result
+
+		if !outcome.win {
+			state.Analysis = &outcome.analysis
+		}
+	}
+
+	return state
+}
+
+// gameStateLocked builds the game_state message for the player in seat
+// playerNum. The caller must hold r.mu.
+func (r *Room) gameStateLocked(playerNum int) GameStateMsg {
+	msg := GameStateMsg{
+		Type:            "game_state",
+		PlayerNumber:    playerNum,
+		PublicGameState: r.publicStateLocked(),
+	}
+
+	if p := r.Players[playerNum-1]; p != nil {
+		msg.PlayerName = p.name
+	}
+
+	game := r.Game
+	if game == nil {
+		return msg
+	}
+
+	msg.Hand = append([]Card(nil), game.Hands[playerNum-1]...)
+	msg.HandUsed = append([]bool(nil), game.HandUsed[playerNum-1]...)
+	msg.SuitHint = game.SuitHintFor(playerNum)
+
+	if game.Phase == PhaseTurnOrderPick {
+		msg.Pick = game.Picks[playerNum-1]
+	}
+
+	return msg
+}
@@ -0,0 +1,65 @@
+package main
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+)
+
+func TestGameStateSnapshot(t *testing.T) {
+	rm := NewRoomManager()
+	room, err := rm.CreateRoom(DefaultRules())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	alice, bob := newTestClient(rm), newTestClient(rm)
+	sendTestMsg(t, alice, JoinRoomMsg{Type: "join_room", Name: "Alice", RoomCode: room.Code})
+	sendTestMsg(t, bob, JoinRoomMsg{Type: "join_room", Name: "Bob", RoomCode: room.Code})
+	sendTestMsg(t, alice, TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(PrefFirst)})
+	sendTestMsg(t, bob, TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(PrefNoFirst)})
+	sendTestMsg(t, alice, PlaceCardMsg{Type: "place_card", CardIndex: 2, SlotIndex: 4})
+	sendTestMsg(t, bob, PassMsg{Type: "pass"})
+	drainTypes(t, bob)
+
+	sendTestMsg(t, bob, RequestStateMsg{Type: "request_state"})
+
+	var state GameStateMsg
+	select {
+	case raw := <-bob.send:
+		if err := json.Unmarshal(raw, &state); err != nil {
+			t.Fatalf("invalid game_state: %v", err)
+		}
+	default:
+		t.Fatal("expected a game_state reply to request_state")
+	}
+
+	room.mu.Lock()
+	game := room.Game
+	wantHand := game.Hands[1]
+	room.mu.Unlock()
+
+	tests := []struct {
+		name string
+		got  any
+		want any
+	}{
+		{"type", state.Type, "game_state"},
+		{"player", state.PlayerNumber, 2},
+		{"name", state.PlayerName, "Bob"},
+		{"phase", state.Phase, PhasePlacement},
+		{"turn", state.CurrentTurn, 1},
+		{"hand", state.Hand, wantHand},
+		{"hand used", state.HandUsed, make([]bool, len(wantHand))},
+		{"slot owner", state.BoardOwner[4], 1},
+		{"passes", state.PassesUsed, []int{0, 1}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if !reflect.DeepEqual(tt.got, tt.want) {
+				t.Errorf("got %v, want %v", tt.got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,382 @@
+package main
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"sort"
+	"strings"
+)
+
+// PlayerView is the part of a Game that one player is allowed to see:
+// their own hand, who owns each board slot, the values of their own placed
+// cards (which they can always peek at) and the public pass and swap state.
+// With a secret suit order, Deck is the player's best guess at the order.
+type PlayerView struct {
+	Rules         Rules
+	Deck          DeckDef
+	SuitHint      *SuitHint
+	PlayerNumber  int
+	Phase         Phase
+	FirstPlayer   int
+	CurrentTurn   int
+	Hand          []Card
+	HandUsed      []bool
+	BoardOwner    []int        // 0 = empty, otherwise the player who placed it
+	Known         map[int]Card // the player's own placed cards by slot
+	PassesUsed    []int        // indexed by playerNumber-1
+	CardsPlaced   []int        // indexed by playerNumber-1
+	SwapsAccepted []int        // indexed by playerNumber-1
+
+	SwapPending    bool
+	SwapSlots      [2]int
+	SwapSuggester  int
+	SwapsCompleted int
+}
+
+// ViewFor returns the legal view of the game for playerNumber (1 to
+// Rules.Players).
+func (g *Game) ViewFor(playerNumber int) PlayerView {
+	idx := playerNumber - 1
+	view := PlayerView{
+		Rules:          g.Rules,
+		Deck:           g.deckFor(playerNumber),
+		SuitHint:       g.SuitHintFor(playerNumber),
+		PlayerNumber:   playerNumber,
+		Phase:          g.Phase,
+		FirstPlayer:    g.FirstPlayer,
+		CurrentTurn:    g.CurrentTurn,
+		Hand:           append([]Card(nil), g.Hands[idx]...),
+		HandUsed:       append([]bool(nil), g.HandUsed[idx]...),
+		BoardOwner:     append([]int(nil), g.BoardOwner...),
+		Known:          make(map[int]Card),
+		PassesUsed:     append([]int(nil), g.PassesUsed...),
+		CardsPlaced:    append([]int(nil), g.CardsPlaced...),
+		SwapsAccepted:  append([]int(nil), g.SwapsAccepted...),
+		SwapPending:    g.SwapPending,
+		SwapSlots:      g.SwapSlots,
+		SwapSuggester:  g.SwapSuggester,
+		SwapsCompleted: g.SwapsCompleted,
+	}
+
+	for slot, card := range g.Board {
+		if card != nil && g.BoardOwner[slot] == playerNumber {
+			view.Known[slot] = *card
+		}
+	}
+
+	return view
+}
+
+// HasPass reports whether the viewing player has a pass left.
+func (v PlayerView) HasPass() bool {
+	return v.PassesUsed[v.PlayerNumber-1] < v.Rules.Passes
+}
+
+// CanSwap reports whether the viewing player may still have a swap accepted.
+func (v PlayerView) CanSwap() bool {
+	return v.SwapsAccepted[v.PlayerNumber-1] < v.Rules.SwapsPerPlayer
+}
+
+// ActionKind identifies what a strategy wants to do on its turn.
+type ActionKind string
+
+const (
+	ActionPlace       ActionKind = "place_card"
+	ActionPass        ActionKind = "pass"
+	ActionSuggestSwap ActionKind = "suggest_swap"
+	ActionSkipSwap    ActionKind = "skip_swap"
+)
+
+// Action is a move chosen by a Strategy. Only the fields relevant to Kind are used.
+type Action struct {
+	Kind      ActionKind
+	CardIndex int
+	SlotIndex int
+	SlotA     int
+	SlotB     int
+}
+
+// Strategy decides moves for one seat from that seat's PlayerView.
+// Act is called when it is the player's turn during placement or swap
+// and no swap is pending. RespondSwap is called when the partner's
+// suggestion is awaiting an answer.
+type Strategy interface {
+	Name() string
+	PickTurnOrder(v PlayerView) Preference
+	Act(v PlayerView) Action
+	RespondSwap(v PlayerView) bool
+}
+
+// defaultStrategy is used for bots when none is requested.
+const defaultStrategy = "spread"
+
+// strategies maps strategy names to constructors.
+var strategies = map[string]func() Strategy{
+	"spread":  func() Strategy { return SpreadStrategy{} },
+	"swapper": func() Strategy { return SwapperStrategy{} },
+	"random":  func() Strategy { return RandomStrategy{} },
+}
+
+// NewStrategy returns the strategy registered under name.
+func NewStrategy(name string) (Strategy, error) {
+	ctor, ok := strategies[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown strategy %q (available: %s)", name, strings.Join(StrategyNames(), ", "))
+	}
+
+	return ctor(), nil
+}
+
+// StrategyNames returns the registered strategy names in sorted order.
+func StrategyNames() []string {
+	names := make([]string, 0, len(strategies))
+	for name := range strategies {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// spreadPassDistance is how far (in slots) the best placement may drift from
+// its estimated position before SpreadStrategy prefers to use its pass.
+const spreadPassDistance = 2
+
+// SpreadStrategy spreads cards across the board in proportion to their sort
+// index, keeping its own cards in order. It passes when every option is far
+// from its estimate, never suggests swaps, and accepts any swap that keeps its
+// own cards ordered.
+type SpreadStrategy struct{}
+
+// Name implements Strategy.
+func (SpreadStrategy) Name() string { return "spread" }
+
+// PickTurnOrder implements Strategy.
+func (SpreadStrategy) PickTurnOrder(PlayerView) Preference { return PrefNeutral }
+
+// Act implements Strategy.
+func (SpreadStrategy) Act(v PlayerView) Action {
+	if v.Phase == PhaseSwap {
+		return Action{Kind: ActionSkipSwap}
+	}
+
+	return spreadPlacement(v)
+}
+
+// RespondSwap implements Strategy.
+func (SpreadStrategy) RespondSwap(v PlayerView) bool {
+	return swapKeepsOrder(v)
+}
+
+// SwapperStrategy places like SpreadStrategy, but on its swap-phase turn it
+// suggests moving its most displaced card one slot towards its estimate by
+// swapping with the partner's neighbouring card.
+type SwapperStrategy struct{}
+
+// Name implements Strategy.
+func (SwapperStrategy) Name() string { return "swapper" }
+
+// PickTurnOrder implements Strategy.
+func (SwapperStrategy) PickTurnOrder(PlayerView) Preference { return PrefNeutral }
+
+// Act implements Strategy.
+func (SwapperStrategy) Act(v PlayerView) Action {
+	if v.Phase != PhaseSwap {
+		return spreadPlacement(v)
+	}
+
+	if !v.CanSwap() {
+		return Action{Kind: ActionSkipSwap}
+	}
+
+	boardSize := len(v.BoardOwner)
+	bestA, bestB, bestDrift := -1, -1, 1
+	for slot, card := range v.Known {
+		drift := estimateSlot(v.Deck, card, boardSize) - slot
+		neighbour := slot + 1
+		if drift < 0 {
+			neighbour = slot - 1
+		}
+
+		if neighbour < 0 || neighbour >= boardSize {
+			continue
+		}
+
+		owner := v.BoardOwner[neighbour]
+		if owner == 0 || owner == v.PlayerNumber {
+			continue
+		}
+
+		if abs(drift) > bestDrift {
+			bestA, bestB, bestDrift = min(slot, neighbour), max(slot, neighbour), abs(drift)
+		}
+	}
+
+	if bestA < 0 {
+		return Action{Kind: ActionSkipSwap}
+	}
+
+	return Action{Kind: ActionSuggestSwap, SlotA: bestA, SlotB: bestB}
+}
+
+// RespondSwap implements Strategy.
+func (SwapperStrategy) RespondSwap(v PlayerView) bool {
+	return swapKeepsOrder(v)
+}
+
+// RandomStrategy makes uniformly random legal moves. It is a baseline for
+// comparing other strategies.
+type RandomStrategy struct{}
+
+// Name implements Strategy.
+func (RandomStrategy) Name() string { return "random" }
+
+// PickTurnOrder implements Strategy.
+func (RandomStrategy) PickTurnOrder(PlayerView) Preference {
+	prefs := []Preference{PrefFirst, PrefNeutral, PrefNoFirst}
+	return prefs[rand.IntN(len(prefs))]
+}
+
+// Act implements Strategy.
+func (RandomStrategy) Act(v PlayerView) Action {
+	if v.Phase == PhaseSwap {
+		return Action{Kind: ActionSkipSwap}
+	}
+
+	var cards, slots []int
+	for i, used := range v.HandUsed {
+		if !used {
+			cards = append(cards, i)
+		}
+	}
+
+	for slot, owner := range v.BoardOwner {
+		if owner == 0 {
+			slots = append(slots, slot)
+		}
+	}
+
+	return Action{
+		Kind:      ActionPlace,
+		CardIndex: cards[rand.IntN(len(cards))],
+		SlotIndex: slots[rand.IntN(len(slots))],
+	}
+}
+
+// RespondSwap implements Strategy.
+func (RandomStrategy) RespondSwap(PlayerView) bool {
+	return rand.IntN(2) == 0
+}
+
+// spreadPlacement picks the unused card whose nearest ordered empty slot is
+// closest to its estimated position, or passes if every option is too far off.
+func spreadPlacement(v PlayerView) Action {
+	boardSize := len(v.BoardOwner)
+	bestCard, bestSlot, bestDist := -1, -1, boardSize
+
+	for i, card := range v.Hand {
+		if v.HandUsed[i] {
+			continue
+		}
+
+		slot, dist := nearestOrderedSlot(v, card, estimateSlot(v.Deck, card, boardSize))
+		if slot >= 0 && dist < bestDist {
+			bestCard, bestSlot, bestDist = i, slot, dist
+		}
+	}
+
+	if bestDist > spreadPassDistance && v.HasPass() {
+		return Action{Kind: ActionPass}
+	}
+
+	return Action{Kind: ActionPlace, CardIndex: bestCard, SlotIndex: bestSlot}
+}
+
+// estimateSlot maps a card's sort index within deck proportionally onto the board.
+func estimateSlot(deck DeckDef, card Card, boardSize int) int {
+	last := deck.Size() - 1
+	if last == 0 {
+		return 0
+	}
+
+	return (card.SortIndex(deck)*(boardSize-1) + last/2) / last
+}
+
+// nearestOrderedSlot returns the empty slot closest to target that keeps card
+// in order with the player's own placed cards, and its distance from target.
+// Falls back to the nearest empty slot if no ordered slot exists.
+func nearestOrderedSlot(v PlayerView, card Card, target int) (int, int) {
+	boardSize := len(v.BoardOwner)
+	nearest, nearestDist := -1, boardSize
+	ordered, orderedDist := -1, boardSize
+
+	for slot, owner := range v.BoardOwner {
+		if owner != 0 {
+			continue
+		}
+
+		dist := abs(slot - target)
+		if dist < nearestDist {
+			nearest, nearestDist = slot, dist
+		}
+
+		if dist < orderedDist && slotInOrder(v.Deck, v.Known, card, slot) {
+			ordered, orderedDist = slot, dist
+		}
+	}
+
+	if ordered >= 0 {
+		return ordered, orderedDist
+	}
+
+	return nearest, nearestDist
+}
+
+// slotInOrder reports whether placing card at slot keeps it sorted relative
+// to the known cards.
+func slotInOrder(deck DeckDef, known map[int]Card, card Card, slot int) bool {
+	for s, placed := range known {
+		if s < slot && placed.SortIndex(deck) > card.SortIndex(deck) {
+			return false
+		}
+
+		if s > slot && placed.SortIndex(deck) < card.SortIndex(deck) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// swapKeepsOrder reports whether the pending swap leaves the player's own
+// known cards in sorted order.
+func swapKeepsOrder(v PlayerView) bool {
+	a, b := v.SwapSlots[0], v.SwapSlots[1]
+	known := make(map[int]Card, len(v.Known))
+	for slot, card := range v.Known {
+		switch slot {
+		case a:
+			known[b] = card
+		case b:
+			known[a] = card
+		default:
+			known[slot] = card
+		}
+	}
+
+	for slot, card := range known {
+		if !slotInOrder(v.Deck, known, card, slot) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+
+	return n
+}
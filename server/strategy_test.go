@@ -0,0 +1,164 @@
+package main
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestViewFor(t *testing.T) {
+	g := newTestGame()
+	g.PlaceCard(1, 0, 3)
+	g.PlaceCard(2, 0, 4)
+
+	v := g.ViewFor(1)
+
+	if len(v.Known) != 1 || v.Known[3] != (Card{Hearts, 1}) {
+		t.Errorf("expected only own card H1 at slot 3 to be known, got %v", v.Known)
+	}
+
+	if v.BoardOwner[4] != 2 {
+		t.Errorf("expected partner ownership of slot 4, got %d", v.BoardOwner[4])
+	}
+
+	if v.Hand[0] != g.Hands[0][0] || !v.HandUsed[0] {
+		t.Error("expected own hand and used flags in view")
+	}
+
+	// The view must be a copy, not an alias of game state.
+	v.HandUsed[1] = true
+	if g.HandUsed[0][1] {
+		t.Error("modifying the view changed the game")
+	}
+}
+
+func TestSpreadPlacement(t *testing.T) {
+	t.Run("places lowest card at the left edge", func(t *testing.T) {
+		g := newTestGame()
+
+		a := SpreadStrategy{}.Act(g.ViewFor(1))
+		if a.Kind != ActionPlace {
+			t.Fatalf("expected a placement, got %s", a.Kind)
+		}
+
+		if a.CardIndex != 0 || a.SlotIndex != 0 {
+			t.Errorf("expected card 0 at slot 0, got card %d at slot %d", a.CardIndex, a.SlotIndex)
+		}
+	})
+
+	t.Run("keeps own cards in order", func(t *testing.T) {
+		g := newTestGame()
+		g.Hands[0] = []Card{{Hearts, 1}, {Hearts, 2}, {Spades, 5}, {Diamonds, 1}, {Diamonds, 9}, {Clubs, 3}, {Clubs, 10}}
+		g.PassesUsed[0] = 1
+
+		// C10 already sits at slot 5 and every remaining card is lower,
+		// so the only ordered slots are to its left.
+		g.Board[5] = &Card{Clubs, 10}
+		g.BoardOwner[5] = 1
+		g.HandUsed[0][6] = true
+
+		a := SpreadStrategy{}.Act(g.ViewFor(1))
+		if a.Kind != ActionPlace {
+			t.Fatalf("expected a placement when pass is used, got %s", a.Kind)
+		}
+
+		if a.SlotIndex >= 5 {
+			t.Errorf("expected slot left of own C10 at 5, got %d", a.SlotIndex)
+		}
+	})
+
+	t.Run("passes when best slot is far from estimate", func(t *testing.T) {
+		g := newTestGame()
+		for s := 0; s < 10; s++ {
+			g.Board[s] = &Card{Spades, 1}
+			g.BoardOwner[s] = 2
+		}
+
+		if a := (SpreadStrategy{}).Act(g.ViewFor(1)); a.Kind != ActionPass {
+			t.Errorf("expected pass when low slots are all taken, got %s", a.Kind)
+		}
+	})
+}
+
+func TestSwapKeepsOrder(t *testing.T) {
+	g := newSwapTestGame()
+
+	// Player 1 owns even slots holding H1..H7 in order.
+	g.SkipSwap(1)
+	if err := g.SuggestSwap(2, 0, 2); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if (SpreadStrategy{}).RespondSwap(g.ViewFor(1)) {
+		t.Error("expected rejection of a swap that inverts own cards")
+	}
+
+	g.SwapPending = false
+	if err := g.SuggestSwap(2, 0, 1); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !(SpreadStrategy{}).RespondSwap(g.ViewFor(1)) {
+		t.Error("expected acceptance of a swap that keeps own cards ordered")
+	}
+}
+
+func TestNewStrategy(t *testing.T) {
+	for _, name := range StrategyNames() {
+		s, err := NewStrategy(name)
+		if err != nil {
+			t.Fatalf("NewStrategy(%q): %v", name, err)
+		}
+
+		if s.Name() != name {
+			t.Errorf("NewStrategy(%q).Name() = %q", name, s.Name())
+		}
+	}
+
+	if _, err := NewStrategy("nope"); err == nil {
+		t.Error("expected error for unknown strategy")
+	}
+}
+
+func TestRunMatch(t *testing.T) {
+	for _, a := range StrategyNames() {
+		for _, b := range StrategyNames() {
+			sa, _ := NewStrategy(a)
+			sb, _ := NewStrategy(b)
+
+			stats, err := RunMatch(sa, sb, DefaultRules(), 50)
+			if err != nil {
+				t.Fatalf("RunMatch(%s, %s): %v", a, b, err)
+			}
+
+			if stats.Games != 50 {
+				t.Errorf("%s vs %s: expected 50 games, got %d", a, b, stats.Games)
+			}
+
+			failures := 0
+			for _, n := range stats.FailedAt {
+				failures += n
+			}
+
+			if failures != stats.Games-stats.Wins {
+				t.Errorf("%s vs %s: %d failures recorded for %d losses", a, b, failures, stats.Games-stats.Wins)
+			}
+		}
+	}
+}
+
+func TestRunTournamentReport(t *testing.T) {
+	var out strings.Builder
+	if err := RunTournament(&out, []string{"spread", "random"}, 10); err != nil {
+		t.Fatalf("RunTournament: %v", err)
+	}
+
+	for _, want := range []string{"WIN RATE", "spread", "random", "CheckWin failures by slot"} {
+		if !strings.Contains(out.String(), want) {
+			t.Errorf("report missing %q:\n%s", want, out.String())
+		}
+	}
+
+	if err := RunTournament(&out, []string{"nope"}, 1); err == nil {
+		t.Error("expected error for unknown strategy")
+	}
+}
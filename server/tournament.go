@@ -0,0 +1,225 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+)
+
+const (
+	// maxTurnOrderRounds caps re-picks in headless games. Strategies that keep
+	// conflicting fall back to player 1 going first.
+	maxTurnOrderRounds = 10
+
+	// maxGameActions caps the number of moves in a headless game so a strategy
+	// that keeps re-suggesting rejected swaps cannot loop forever.
+	maxGameActions = 500
+)
+
+// MatchStats aggregates the results of many games between two strategies.
+// Per-strategy counters are indexed 0 for the first strategy and 1 for the
+// second; with more than two players the strategies alternate seats.
+type MatchStats struct {
+	Strategies     [2]string
+	Games          int
+	Wins           int
+	FailedAt       []int // how often CheckWin failed at each slot
+	Passes         [2]int
+	SwapsSuggested [2]int
+	SwapsAccepted  [2]int
+}
+
+// WinRate returns the fraction of games won.
+func (s *MatchStats) WinRate() float64 {
+	if s.Games == 0 {
+		return 0
+	}
+
+	return float64(s.Wins) / float64(s.Games)
+}
+
+// perGame returns n averaged over the number of games played.
+func (s *MatchStats) perGame(n int) float64 {
+	if s.Games == 0 {
+		return 0
+	}
+
+	return float64(n) / float64(s.Games)
+}
+
+// RunMatch plays games headless games under rules with strategy a in the
+// odd seats and strategy b in the even seats, driving the Game state machine
+// directly.
+func RunMatch(a, b Strategy, rules Rules, games int) (*MatchStats, error) {
+	stats := &MatchStats{
+		Strategies: [2]string{a.Name(), b.Name()},
+		FailedAt:   make([]int, rules.BoardSize),
+	}
+
+	seats := make([]Strategy, rules.Players)
+	for i := range seats {
+		seats[i] = a
+		if i%2 == 1 {
+			seats[i] = b
+		}
+	}
+
+	for i := 0; i < games; i++ {
+		if err := playHeadless(seats, rules, stats); err != nil {
+			return nil, fmt.Errorf("%s vs %s, game %d: %w", a.Name(), b.Name(), i+1, err)
+		}
+	}
+
+	return stats, nil
+}
+
+// playHeadless plays a single game between seats and records it in stats.
+func playHeadless(seats []Strategy, rules Rules, stats *MatchStats) error {
+	g, err := NewGame(rules)
+	if err != nil {
+		return err
+	}
+
+	for round := 0; !g.AllPicked(); round++ {
+		for i, s := range seats {
+			g.SetPick(i+1, s.PickTurnOrder(g.ViewFor(i+1)))
+		}
+
+		first, conflict := g.ResolveTurnOrder()
+		if !conflict {
+			g.StartPlacement(first)
+			break
+		}
+
+		if round+1 >= maxTurnOrderRounds {
+			g.StartPlacement(1)
+			break
+		}
+
+		g.ResetPicks()
+	}
+
+	for actions := 0; g.Phase == PhasePlacement || g.Phase == PhaseSwap; actions++ {
+		if actions >= maxGameActions {
+			return fmt.Errorf("no result after %d actions", maxGameActions)
+		}
+
+		if g.SwapPending {
+			// The next seat after the suggester answers for the table.
+			responder := g.nextPlayer(g.SwapSuggester)
+			accept := seats[responder-1].RespondSwap(g.ViewFor(responder))
+			if accept {
+				stats.SwapsAccepted[(g.SwapSuggester-1)%2]++
+			}
+
+			if err := g.RespondSwap(responder, accept); err != nil {
+				return fmt.Errorf("%s respond_swap: %w", seats[responder-1].Name(), err)
+			}
+
+			continue
+		}
+
+		player := g.CurrentTurn
+		action := seats[player-1].Act(g.ViewFor(player))
+		if err := applyAction(g, player, action); err != nil {
+			return fmt.Errorf("%s %s: %w", seats[player-1].Name(), action.Kind, err)
+		}
+
+		switch action.Kind {
+		case ActionPass:
+			stats.Passes[(player-1)%2]++
+		case ActionSuggestSwap:
+			stats.SwapsSuggested[(player-1)%2]++
+		}
+	}
+
+	_, win := g.FinalizeReveal()
+	stats.Games++
+	if win {
+		stats.Wins++
+	} else {
+		stats.FailedAt[g.FailedSlot()]++
+	}
+
+	return nil
+}
+
+// applyAction performs a strategy's action through the same Game validation
+// used for human players.
+func applyAction(g *Game, playerNumber int, a Action) error {
+	switch a.Kind {
+	case ActionPlace:
+		return g.PlaceCard(playerNumber, a.CardIndex, a.SlotIndex)
+	case ActionPass:
+		return g.UsePass(playerNumber)
+	case ActionSuggestSwap:
+		return g.SuggestSwap(playerNumber, a.SlotA, a.SlotB)
+	case ActionSkipSwap:
+		return g.SkipSwap(playerNumber)
+	}
+
+	return fmt.Errorf("unknown action %q", a.Kind)
+}
+
+// RunTournament plays every ordered pairing of the named strategies (including
+// each strategy against itself) and writes a report to w.
+func RunTournament(w io.Writer, names []string, games int) error {
+	seats := make([]Strategy, 0, len(names))
+	for _, name := range names {
+		s, err := NewStrategy(strings.TrimSpace(name))
+		if err != nil {
+			return err
+		}
+
+		seats = append(seats, s)
+	}
+
+	results := make([]*MatchStats, 0, len(seats)*len(seats))
+	for _, a := range seats {
+		for _, b := range seats {
+			stats, err := RunMatch(a, b, DefaultRules(), games)
+			if err != nil {
+				return err
+			}
+
+			results = append(results, stats)
+		}
+	}
+
+	return writeReport(w, results)
+}
+
+// writeReport prints a summary table followed by per-slot failure counts.
+func writeReport(w io.Writer, results []*MatchStats) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "P1\tP2\tGAMES\tWIN RATE\tPASSES/GAME\tSWAPS SUGGESTED/GAME\tSWAPS ACCEPTED/GAME")
+
+	for _, s := range results {
+		fmt.Fprintf(tw, "%s\t%s\t%d\t%.1f%%\t%.2f / %.2f\t%.2f / %.2f\t%.2f / %.2f\n",
+			s.Strategies[0], s.Strategies[1], s.Games, s.WinRate()*100,
+			s.perGame(s.Passes[0]), s.perGame(s.Passes[1]),
+			s.perGame(s.SwapsSuggested[0]), s.perGame(s.SwapsSuggested[1]),
+			s.perGame(s.SwapsAccepted[0]), s.perGame(s.SwapsAccepted[1]))
+	}
+
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "CheckWin failures by slot:")
+
+	for _, s := range results {
+		parts := make([]string, 0, len(s.FailedAt))
+		for slot, n := range s.FailedAt {
+			if n > 0 {
+				parts = append(parts, fmt.Sprintf("%d:%d", slot, n))
+			}
+		}
+
+		fmt.Fprintf(w, "  %s vs %s: %s\n", s.Strategies[0], s.Strategies[1], strings.Join(parts, " "))
+	}
+
+	return nil
+}
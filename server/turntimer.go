@@ -0,0 +1,169 @@
+package main
+
+import (
+	"encoding/json"
+	"log/slog"
+	"time"
+)
+
+// timeoutMove is a move the turn timer makes for a player who ran out of time.
+type timeoutMove struct {
+	Player int
+	Msg    any
+}
+
+// TurnDeadline returns when the turn timer runs out, or the zero time if the
+// room has no turn timer or the game is not waiting on a move. Every action
+// except a peek or a signal restarts the clock. A move the game was already
+// waiting on when it was restored gets at least the grace period from then,
+// so players can reconnect before the timer acts for them.
+func (g *Game) TurnDeadline() time.Time {
+	if g.Rules.TurnSeconds == 0 || (g.Phase != PhasePlacement && g.Phase != PhaseSwap) {
+		return time.Time{}
+	}
+
+	last := g.lastAction()
+	if last == nil {
+		return time.Time{}
+	}
+
+	deadline := last.At.Add(time.Duration(g.Rules.TurnSeconds) * time.Second)
+	if resumed := g.restored.Add(gracePeriod); last.At.Before(g.restored) && deadline.Before(resumed) {
+		return resumed
+	}
+
+	return deadline
+}
+
+// timeoutMoves returns the moves the turn timer makes when it runs out: an
+// unanswered undo request or swap suggestion is rejected, and the player
+// whose turn it is uses a pass if they have one, places their next unused
+// card in the empty slot closest to where it belongs, or skips their swap.
+func (g *Game) timeoutMoves() []timeoutMove {
+	var moves []timeoutMove
+	if _, requester, ok := g.PendingUndo(); ok {
+		moves = append(moves, timeoutMove{
+			Player: g.nextPlayer(requester),
+			Msg:    RespondUndoMsg{Type: "respond_undo", Accept: false},
+		})
+	}
+
+	if g.SwapPending {
+		moves = append(moves, timeoutMove{
+			Player: g.nextPlayer(g.SwapSuggester),
+			Msg:    RespondSwapMsg{Type: "respond_swap", Accept: false},
+		})
+	}
+
+	switch g.Phase {
+	case PhasePlacement:
+		if g.HasPass(g.CurrentTurn) {
+			moves = append(moves, timeoutMove{Player: g.CurrentTurn, Msg: PassMsg{Type: "pass"}})
+			break
+		}
+
+		if card, slot := g.fallbackPlacement(g.CurrentTurn); card >= 0 {
+			moves = append(moves, timeoutMove{
+				Player: g.CurrentTurn,
+				Msg:    PlaceCardMsg{Type: "place_card", CardIndex: card, SlotIndex: slot},
+			})
+		}
+	case PhaseSwap:
+		if !g.SwapPending {
+			moves = append(moves, timeoutMove{Player: g.CurrentTurn, Msg: SkipSwapMsg{Type: "skip_swap"}})
+		}
+	}
+
+	return moves
+}
+
+// fallbackPlacement picks the player's first unused card and the empty slot
+// nearest its proportional position on the board, as the player knows the
+// deck. It returns -1, -1 if the player has no card to place.
+func (g *Game) fallbackPlacement(playerNumber int) (int, int) {
+	idx := playerNumber - 1
+	for card, used := range g.HandUsed[idx] {
+		if used {
+			continue
+		}
+
+		target := estimateSlot(g.deckFor(playerNumber), g.Hands[idx][card], len(g.Board))
+		slot, dist := -1, len(g.Board)
+		for i, owner := range g.BoardOwner {
+			if owner == 0 && abs(i-target) < dist {
+				slot, dist = i, abs(i-target)
+			}
+		}
+
+		return card, slot
+	}
+
+	return -1, -1
+}
+
+// deadlineMillis converts a deadline to Unix milliseconds for clients, with
+// 0 for no deadline.
+func deadlineMillis(deadline time.Time) int64 {
+	if deadline.IsZero() {
+		return 0
+	}
+
+	return deadline.UnixMilli()
+}
+
+// armTurnTimer restarts the room's turn timer for whatever the game is now
+// waiting on, or stops it if the game is not waiting on a timed move.
+func (r *Room) armTurnTimer() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.turnTimer != nil {
+		r.turnTimer.Stop()
+		r.turnTimer = nil
+	}
+
+	game := r.Game
+	if game == nil || r.closed {
+		return
+	}
+
+	deadline := game.TurnDeadline()
+	if deadline.IsZero() {
+		return
+	}
+
+	r.turnTimer = time.AfterFunc(time.Until(deadline), func() {
+		r.turnExpired(game, deadline)
+	})
+}
+
+// turnExpired makes the timeout moves if the game is still waiting on the
+// move the timer was started for.
+func (r *Room) turnExpired(game *Game, deadline time.Time) {
+	r.mu.Lock()
+	if r.closed || r.Game != game || !game.TurnDeadline().Equal(deadline) {
+		r.mu.Unlock()
+		return
+	}
+
+	moves := game.timeoutMoves()
+	names := r.namesLocked()
+	r.mu.Unlock()
+
+	for _, move := range moves {
+		raw, err := json.Marshal(move.Msg)
+		if err != nil {
+			slog.Error("failed to marshal timeout move", "error", err)
+			continue
+		}
+
+		slog.Info("turn timer expired", "player", names[move.Player-1], "room", r.Code)
+		r.standIn(names[move.Player-1], move.Player).handleMessage(raw)
+	}
+}
+
+// standIn returns a connectionless client that acts for a seat. Anything
+// sent to it, such as an error, is discarded.
+func (r *Room) standIn(name string, playerNumber int) *Client {
+	return &Client{room: r, name: name, playerNumber: playerNumber, out: newOutbox(nil)}
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestTurnDeadline(t *testing.T) {
+	g := newTestGame()
+	if !g.TurnDeadline().IsZero() {
+		t.Error("expected no deadline without a turn timer")
+	}
+
+	g.Rules.TurnSeconds = 30
+	g.PlaceCard(1, 0, 0)
+	want := g.Events[len(g.Events)-1].At.Add(30 * time.Second)
+	if got := g.TurnDeadline(); !got.Equal(want) {
+		t.Errorf("deadline = %v, want %v", got, want)
+	}
+
+	if _, err := g.Peek(1, 0); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got := g.TurnDeadline(); !got.Equal(want) {
+		t.Errorf("expected a peek to leave the deadline alone, got %v", got)
+	}
+
+	g.Phase = PhaseGameOver
+	if !g.TurnDeadline().IsZero() {
+		t.Error("expected no deadline once the game is over")
+	}
+}
+
+func TestTimeoutMoves(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(g *Game)
+		want   []timeoutMove
+	}{
+		{
+			name:   "pass first",
+			modify: func(g *Game) {},
+			want:   []timeoutMove{{1, PassMsg{Type: "pass"}}},
+		},
+		{
+			name:   "place without a pass",
+			modify: func(g *Game) { g.PassesUsed[0] = 1 },
+			want:   []timeoutMove{{1, PlaceCardMsg{Type: "place_card", CardIndex: 0, SlotIndex: 0}}},
+		},
+		{
+			name: "place near the card's position",
+			modify: func(g *Game) {
+				g.Rules.Passes = 0
+				g.HandUsed[0][0] = true
+				g.BoardOwner[1] = 2
+			},
+			want: []timeoutMove{{1, PlaceCardMsg{Type: "place_card", CardIndex: 1, SlotIndex: 0}}},
+		},
+		{
+			name: "reject an unanswered suggestion",
+			modify: func(g *Game) {
+				g.SwapPending = true
+				g.SwapSuggester = 2
+			},
+			want: []timeoutMove{
+				{1, RespondSwapMsg{Type: "respond_swap"}},
+				{1, PassMsg{Type: "pass"}},
+			},
+		},
+		{
+			name:   "skip a swap turn",
+			modify: func(g *Game) { g.Phase, g.CurrentTurn = PhaseSwap, 2 },
+			want:   []timeoutMove{{2, SkipSwapMsg{Type: "skip_swap"}}},
+		},
+		{
+			name: "reject in the swap phase",
+			modify: func(g *Game) {
+				g.Phase = PhaseSwap
+				g.SwapPending = true
+				g.SwapSuggester = 1
+			},
+			want: []timeoutMove{{2, RespondSwapMsg{Type: "respond_swap"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := newTestGame()
+			tt.modify(g)
+
+			if got := g.timeoutMoves(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("timeoutMoves() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTurnExpiredActsForPlayer(t *testing.T) {
+	rules := DefaultRules()
+	rules.TurnSeconds = 30
+
+	rm := NewRoomManager()
+	room, err := rm.CreateRoom(rules)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	alice, bob := newTestClient(rm), newTestClient(rm)
+	sendTestMsg(t, alice, JoinRoomMsg{Type: "join_room", Name: "Alice", RoomCode: room.Code})
+	sendTestMsg(t, bob, JoinRoomMsg{Type: "join_room", Name: "Bob", RoomCode: room.Code})
+	sendTestMsg(t, alice, TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(PrefFirst)})
+	sendTestMsg(t, bob, TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(PrefNoFirst)})
+	defer rm.RemoveRoom(room.Code)
+
+	room.mu.Lock()
+	game := room.Game
+	deadline := game.TurnDeadline()
+	armed := room.turnTimer != nil
+	room.mu.Unlock()
+
+	if !armed {
+		t.Fatal("expected the turn timer to be running")
+	}
+
+	room.turnExpired(game, deadline.Add(-time.Second))
+	if game.PassesUsed[0] != 0 {
+		t.Fatal("expected a stale timer to do nothing")
+	}
+
+	drainTypes(t, bob)
+	room.turnExpired(game, deadline)
+
+	room.mu.Lock()
+	passes, turn := game.PassesUsed[0], game.CurrentTurn
+	room.mu.Unlock()
+
+	if passes != 1 || turn != 2 {
+		t.Errorf("expected Alice's pass to be used, got %d passes and turn %d", passes, turn)
+	}
+
+	if got := drainTypes(t, bob); !reflect.DeepEqual(got, []string{"player_passed", "your_turn"}) {
+		t.Errorf("Bob received %v", got)
+	}
+}
@@ -0,0 +1,182 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+)
+
+// RequestUndo asks the other players to let a player take back the card they
+// just placed. Only a placement that is still the last action can be undone.
+func (g *Game) RequestUndo(playerNumber int) error {
+	if g.Phase != PhasePlacement && g.Phase != PhaseSwap {
+		return fmt.Errorf("nothing to undo")
+	}
+
+	if g.SwapPending {
+		return fmt.Errorf("a swap is pending")
+	}
+
+	last := g.lastAction()
+	if last == nil || last.Action != ReplayPlace || last.Player != playerNumber {
+		return fmt.Errorf("nothing to undo")
+	}
+
+	g.record(ReplayUndoRequest, playerNumber, last.Details)
+	return nil
+}
+
+// PendingUndo returns the placement a player asked to undo and who asked.
+// A request lapses as soon as anyone acts instead of answering it.
+func (g *Game) PendingUndo() (PlaceDetails, int, bool) {
+	last := g.lastAction()
+	if last == nil || last.Action != ReplayUndoRequest {
+		return PlaceDetails{}, 0, false
+	}
+
+	var placed PlaceDetails
+	if err := json.Unmarshal(last.Details, &placed); err != nil {
+		return PlaceDetails{}, 0, false
+	}
+
+	return placed, last.Player, true
+}
+
+// RespondUndo answers a pending undo request. Any player other than the one
+// who asked may answer; the first answer decides. On accept the card goes
+// back to its owner's hand and it is their turn again, back in the placement
+// phase if their card had ended it.
+func (g *Game) RespondUndo(playerNumber int, accept bool) error {
+	placed, requester, ok := g.PendingUndo()
+	if !ok {
+		return fmt.Errorf("no undo pending")
+	}
+
+	if playerNumber == requester {
+		return fmt.Errorf("cannot respond to your own undo")
+	}
+
+	g.record(ReplayRespondUndo, playerNumber, RespondDetails{Accept: accept})
+
+	if !accept {
+		return nil
+	}
+
+	idx := requester - 1
+	g.Board[placed.SlotIndex] = nil
+	g.BoardOwner[placed.SlotIndex] = 0
+	g.HandUsed[idx][placed.CardIndex] = false
+	g.CardsPlaced[idx]--
+	g.removeSignal(placed.SlotIndex)
+
+	// No swap turn can have been taken since the last card was placed
+	g.Phase = PhasePlacement
+	g.SwapsCompleted = 0
+	g.CurrentTurn = requester
+
+	return nil
+}
+
+func (c *Client) handleUndoRequest() {
+	if c.room == nil {
+		c.SendMsg(newError("no active game"))
+		return
+	}
+
+	c.room.mu.Lock()
+	game := c.room.Game
+	if game == nil {
+		c.room.mu.Unlock()
+		c.SendMsg(newError("no active game"))
+		return
+	}
+
+	if err := game.RequestUndo(c.playerNumber); err != nil {
+		c.room.mu.Unlock()
+		c.SendMsg(newError(err.Error()))
+		return
+	}
+
+	placed, _, _ := game.PendingUndo()
+	deadline := deadlineMillis(game.TurnDeadline())
+	audience := c.room.audienceLocked()
+	c.room.mu.Unlock()
+
+	slog.Info("undo requested", "player", c.name, "slot", placed.SlotIndex, "room", c.room.Code)
+
+	broadcast(audience, UndoRequestedMsg{
+		Type:      "undo_requested",
+		SlotIndex: placed.SlotIndex,
+		ByPlayer:  c.playerNumber,
+		Deadline:  deadline,
+	})
+}
+
+func (c *Client) handleRespondUndo(raw []byte) {
+	var msg RespondUndoMsg
+	if err := json.Unmarshal(raw, &msg); err != nil {
+		c.SendMsg(newError("invalid respond_undo message"))
+		return
+	}
+
+	if c.room == nil {
+		c.SendMsg(newError("no active game"))
+		return
+	}
+
+	c.room.mu.Lock()
+	game := c.room.Game
+	if game == nil {
+		c.room.mu.Unlock()
+		c.SendMsg(newError("no active game"))
+		return
+	}
+
+	placed, requester, _ := game.PendingUndo()
+	if err := game.RespondUndo(c.playerNumber, msg.Accept); err != nil {
+		c.room.mu.Unlock()
+		c.SendMsg(newError(err.Error()))
+		return
+	}
+
+	phase := game.Phase
+	currentTurn := game.CurrentTurn
+	deadline := deadlineMillis(game.TurnDeadline())
+	handUsed := append([]bool(nil), game.HandUsed[requester-1]...)
+	players := c.room.playersLocked()
+	audience := c.room.audienceLocked()
+	c.room.mu.Unlock()
+
+	slog.Info("undo response", "player", c.name, "accepted", msg.Accept, "room", c.room.Code)
+
+	result := UndoResultMsg{
+		Type:      "undo_result",
+		Accepted:  msg.Accept,
+		SlotIndex: placed.SlotIndex,
+		ByPlayer:  requester,
+	}
+
+	// The requester's own copy also gives them their card back
+	own := result
+	if msg.Accept {
+		own.HandUsed = handUsed
+	}
+
+	for i, o := range audience {
+		switch {
+		case o == nil:
+		case i == requester-1:
+			o.SendMsg(own)
+		default:
+			o.SendMsg(result)
+		}
+	}
+
+	switch {
+	case phase == PhasePlacement && (msg.Accept || deadline != 0):
+		sendYourTurn(currentTurn, players, deadline)
+	case phase == PhaseSwap && deadline != 0:
+		// The answer restarted the clock for the player whose turn it is
+		broadcast(audience, SwapPromptMsg{Type: "swap_prompt", ByPlayer: currentTurn, Deadline: deadline})
+	}
+}
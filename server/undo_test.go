@@ -0,0 +1,146 @@
+package main
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestUndo(t *testing.T) {
+	t.Run("accept takes the card back", func(t *testing.T) {
+		g := newTestGame()
+		g.PlaceCard(1, 2, 5)
+
+		if err := g.RequestUndo(1); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if err := g.RespondUndo(1, true); err == nil {
+			t.Error("expected error answering your own undo")
+		}
+
+		if err := g.RespondUndo(2, true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if g.Board[5] != nil || g.BoardOwner[5] != 0 {
+			t.Error("expected the slot to be empty again")
+		}
+
+		if g.HandUsed[0][2] || g.CardsPlaced[0] != 0 {
+			t.Error("expected the card back in the hand")
+		}
+
+		if g.CurrentTurn != 1 {
+			t.Errorf("expected player 1's turn again, got %d", g.CurrentTurn)
+		}
+
+		if _, _, ok := g.PendingUndo(); ok {
+			t.Error("expected no undo pending after the answer")
+		}
+	})
+
+	t.Run("reject keeps the card", func(t *testing.T) {
+		g := newTestGame()
+		g.PlaceCard(1, 0, 0)
+		g.RequestUndo(1)
+
+		if err := g.RespondUndo(2, false); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if g.Board[0] == nil || g.CurrentTurn != 2 {
+			t.Error("expected the placement to stand")
+		}
+
+		if err := g.RequestUndo(1); err == nil {
+			t.Error("expected error asking again after a rejection")
+		}
+	})
+
+	t.Run("only the last placement", func(t *testing.T) {
+		g := newTestGame()
+		g.PlaceCard(1, 0, 0)
+
+		if err := g.RequestUndo(2); err == nil {
+			t.Error("expected error undoing another player's card")
+		}
+
+		g.Peek(1, 0)
+		if err := g.RequestUndo(1); err != nil {
+			t.Errorf("expected a peek not to count as an action: %v", err)
+		}
+
+		g.UsePass(2)
+		if err := g.RespondUndo(2, true); err == nil {
+			t.Error("expected the request to lapse after another action")
+		}
+
+		if err := g.RequestUndo(1); err == nil {
+			t.Error("expected error after another action")
+		}
+	})
+
+	t.Run("last card reopens placement", func(t *testing.T) {
+		g := newTestGame()
+		for i := range 7 {
+			g.PlaceCard(1, i, i)
+			g.PlaceCard(2, i, 7+i)
+		}
+
+		if g.Phase != PhaseSwap {
+			t.Fatalf("expected swap phase, got %s", g.Phase)
+		}
+
+		g.RequestUndo(2)
+		if err := g.RespondUndo(1, true); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if g.Phase != PhasePlacement || g.CurrentTurn != 2 || g.SwapsCompleted != 0 {
+			t.Errorf("expected player 2 to place again, got phase %s turn %d", g.Phase, g.CurrentTurn)
+		}
+
+		if err := g.PlaceCard(2, 6, 14); err != nil {
+			t.Errorf("unexpected error placing again: %v", err)
+		}
+	})
+}
+
+func TestUndoResultGivesCardBack(t *testing.T) {
+	rm := NewRoomManager()
+	room, err := rm.CreateRoom(DefaultRules())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	alice, bob := newTestClient(rm), newTestClient(rm)
+	sendTestMsg(t, alice, JoinRoomMsg{Type: "join_room", Name: "Alice", RoomCode: room.Code})
+	sendTestMsg(t, bob, JoinRoomMsg{Type: "join_room", Name: "Bob", RoomCode: room.Code})
+	sendTestMsg(t, alice, TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(PrefFirst)})
+	sendTestMsg(t, bob, TurnOrderPickMsg{Type: "turn_order_pick", Preference: string(PrefNoFirst)})
+	sendTestMsg(t, alice, PlaceCardMsg{Type: "place_card", CardIndex: 3, SlotIndex: 4})
+	sendTestMsg(t, alice, UndoRequestMsg{Type: "undo_request"})
+	drainTypes(t, alice)
+	sendTestMsg(t, bob, RespondUndoMsg{Type: "respond_undo", Accept: true})
+
+	var result UndoResultMsg
+	if err := json.Unmarshal(<-alice.send, &result); err != nil {
+		t.Fatalf("invalid message: %v", err)
+	}
+
+	if result.Type != "undo_result" || !result.Accepted || result.SlotIndex != 4 || result.ByPlayer != 1 {
+		t.Errorf("unexpected undo result: %+v", result)
+	}
+
+	if len(result.HandUsed) != 7 || result.HandUsed[3] {
+		t.Errorf("expected card 3 back in Alice's hand, got %v", result.HandUsed)
+	}
+
+	if got := drainTypes(t, alice); len(got) != 1 || got[0] != "your_turn" {
+		t.Errorf("expected your_turn after the undo, got %v", got)
+	}
+
+	if got := drainTypes(t, bob); len(got) == 0 || got[len(got)-1] != "undo_result" {
+		t.Errorf("expected Bob to see the undo result, got %v", got)
+	}
+}
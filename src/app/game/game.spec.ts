@@ -82,6 +82,7 @@ describe('GameComponent', () => {
       playerName: 'Alice',
       playerNumber: 1,
       partnerName: 'Bob',
+      token: 'tok',
     });
 
     fixture.detectChanges();
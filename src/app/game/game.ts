@@ -7,7 +7,7 @@ import { CdkDropListGroup } from '@angular/cdk/drag-drop';
 import { WebSocketService } from '../shared/websocket.service';
 import { GameStateService, type TurnOrderPreference } from '../shared/game-state.service';
 import { CardStyleService } from '../shared/card-style.service';
-import { ServerMessage, GameStartMessage, SwapPromptMessage } from '../shared/messages';
+import { ServerMessage, GameStartMessage, GameStateMessage, SwapPromptMessage } from '../shared/messages';
 import { TurnOrderPickComponent } from './turn-order-pick/turn-order-pick';
 import { BoardComponent } from './board/board';
 import { HandComponent } from './hand/hand';
@@ -107,7 +107,7 @@ export class GameComponent implements OnInit, OnDestroy {
           this.partnerDisconnected.set(false);
           this.partnerLeftMessage.set('');
           // Store credentials for auto-reconnection
-          this.ws.setReconnectCredentials(msg.playerName, this.gameState.roomCode() || this.roomId());
+          this.ws.setReconnectCredentials(msg.playerName, this.gameState.roomCode() || this.roomId(), msg.token);
           break;
         }
         case 'player_disconnected': {
@@ -131,6 +131,7 @@ export class GameComponent implements OnInit, OnDestroy {
           } else {
             // Clear any stale result from a previous session (GameStateService is a singleton)
             this.gameState.turnOrderResult.set(null);
+            this.gameState.myPick.set(null);
           }
           this.gameState.hand.set(msg.hand);
           this.gameState.phase.set('turn_order_pick');
@@ -157,6 +158,10 @@ export class GameComponent implements OnInit, OnDestroy {
           this.applyGameStart(msg);
           break;
         }
+        case 'game_state': {
+          this.applyGameState(msg);
+          break;
+        }
         case 'your_turn': {
           this.gameState.isMyTurn.set(true);
           break;
@@ -328,10 +333,12 @@ export class GameComponent implements OnInit, OnDestroy {
   }
 
   onTurnOrderPick(preference: TurnOrderPreference): void {
+    this.gameState.myPick.set(preference);
     this.ws.send({ type: 'turn_order_pick', preference });
   }
 
   onTurnOrderRepick(): void {
+    this.gameState.myPick.set(null);
     this.gameState.turnOrderResult.set(null);
   }
 
@@ -469,13 +476,64 @@ export class GameComponent implements OnInit, OnDestroy {
     }, 2500);
   }
 
+  /** Replace everything we know about the game with the server's snapshot. */
+  private applyGameState(msg: GameStateMessage): void {
+    this.revealTimeouts.forEach(t => clearTimeout(t));
+    this.revealTimeouts = [];
+    this.bufferedGameStart = null;
+    this.bufferedSwapPrompt = null;
+    this.showTurnOrderPopup.set(false);
+    this.showSwapPhasePopup.set(false);
+    this.selectedSwapSlots.set([]);
+    this.placementSwapMode.set(false);
+    this.maxRevealDelay = 0;
+
+    const me = msg.playerNumber - 1;
+    const partner = msg.playerNumber === 1 ? 1 : 0;
+    const passes = msg.passesUsed ?? [];
+    const swaps = msg.swapsAccepted ?? [];
+    const ready = msg.playAgainReady ?? [];
+
+    this.gameState.phase.set(msg.phase);
+    this.gameState.hand.set(msg.hand ?? []);
+    this.gameState.handUsed.set(msg.handUsed ?? new Array(msg.hand?.length ?? 0).fill(false));
+    this.gameState.myPick.set((msg.pick as TurnOrderPreference | undefined) || null);
+    this.gameState.firstPlayer.set(msg.firstPlayer ?? 0);
+    this.gameState.currentTurn.set(msg.currentTurn ?? 0);
+    this.gameState.isMyTurn.set(msg.phase === 'placement' && msg.currentTurn === msg.playerNumber);
+    this.gameState.passUsed.set([(passes[0] ?? 0) > 0, (passes[1] ?? 0) > 0]);
+    this.gameState.swapAccepted.set([(swaps[0] ?? 0) > 0, (swaps[1] ?? 0) > 0]);
+    this.gameState.swapHistory.set(msg.swapHistory ?? []);
+    this.gameState.swapPending.set(!!msg.swapPending);
+    this.gameState.swapSlots.set(msg.swapPending ? [msg.swapPending.slotA, msg.swapPending.slotB] : null);
+    this.gameState.swapSuggester.set(msg.swapPending?.byPlayer ?? 0);
+    this.gameState.lastPlacedSlot.set(-1);
+
+    this.gameState.clearBoard();
+    const board = [...this.gameState.board()];
+    (msg.boardOwner ?? []).forEach((byPlayer, i) => {
+      board[i] = { occupied: byPlayer > 0, byPlayer };
+    });
+    msg.result?.board.forEach(({ slotIndex, card }) => {
+      board[slotIndex] = { ...board[slotIndex], card };
+    });
+    this.gameState.board.set(board);
+
+    const revealed = msg.result?.board.length ?? 0;
+    this.gameState.revealedCount.set(revealed);
+    this.gameState.totalRevealCards.set(revealed);
+    this.gameState.gameResult.set(msg.result ? { win: msg.result.win } : null);
+    this.gameState.partnerWantsRematch.set(!!ready[partner]);
+    this.gameState.playAgainSent.set(!!ready[me]);
+  }
+
   private applyGameStart(msg: GameStartMessage): void {
     this.gameState.hand.set(msg.hand);
     this.gameState.firstPlayer.set(msg.firstPlayer);
     this.gameState.currentTurn.set(msg.firstPlayer);
     this.gameState.phase.set('placement');
     this.gameState.clearBoard();
-    this.gameState.handUsed.set(msg.handUsed ?? new Array(7).fill(false));
+    this.gameState.handUsed.set(new Array(msg.hand.length).fill(false));
     this.gameState.passUsed.set([false, false]);
     this.gameState.swapAccepted.set([false, false]);
     this.gameState.swapHistory.set([]);
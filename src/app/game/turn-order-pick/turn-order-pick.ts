@@ -1,4 +1,4 @@
-import { ChangeDetectionStrategy, Component, input, output, signal } from '@angular/core';
+import { ChangeDetectionStrategy, Component, input, linkedSignal, output } from '@angular/core';
 import { TurnOrderPreference, TurnOrderResult } from '../../shared/game-state.service';
 
 @Component({
@@ -11,11 +11,13 @@ export class TurnOrderPickComponent {
   readonly partnerName = input.required<string>();
   readonly playerNumber = input.required<number>();
   readonly turnOrderResult = input<TurnOrderResult | null>(null);
+  /** A pick already sent this round, e.g. before reconnecting. */
+  readonly initialPick = input<TurnOrderPreference | null>(null);
 
   readonly preferenceSelected = output<TurnOrderPreference>();
   readonly repicked = output<void>();
 
-  readonly picked = signal<TurnOrderPreference | null>(null);
+  readonly picked = linkedSignal<TurnOrderPreference | null>(() => this.initialPick());
 
   pick(preference: TurnOrderPreference): void {
     if (this.picked()) return;
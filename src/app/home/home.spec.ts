@@ -77,6 +77,7 @@ describe('HomeComponent', () => {
       type: 'room_created',
       roomCode: 'ABCD',
       playerNumber: 1,
+      token: 'tok',
     } as RoomCreatedMessage);
 
     expect(router.navigate).toHaveBeenCalledWith(['/game', 'ABCD']);
@@ -107,6 +108,7 @@ describe('HomeComponent', () => {
       playerName: 'Bob',
       playerNumber: 2,
       partnerName: 'Alice',
+      token: 'tok',
     } as PlayerJoinedMessage);
 
     expect(router.navigate).toHaveBeenCalledWith(['/game', 'XYZ']);
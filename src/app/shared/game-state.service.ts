@@ -28,6 +28,8 @@ export class GameStateService {
   readonly phase = signal<string>('lobby');
   readonly hand = signal<Card[]>([]);
   readonly turnOrderResult = signal<TurnOrderResult | null>(null);
+  /** The turn order preference this player has sent in the current round, if any. */
+  readonly myPick = signal<TurnOrderPreference | null>(null);
   readonly firstPlayer = signal(0);
   readonly currentTurn = signal(0);
   readonly isMyTurn = signal(false);
@@ -79,6 +81,7 @@ export class GameStateService {
     this.phase.set('lobby');
     this.hand.set([]);
     this.turnOrderResult.set(null);
+    this.myPick.set(null);
     this.firstPlayer.set(0);
     this.currentTurn.set(0);
     this.isMyTurn.set(false);
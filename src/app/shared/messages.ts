@@ -76,13 +76,20 @@ export interface ExitGameMessage extends BaseMessage {
   type: 'exit_game';
 }
 
+export interface RequestStateMessage extends BaseMessage {
+  type: 'request_state';
+}
+
 export interface ReconnectMessage extends BaseMessage {
   type: 'reconnect';
   name: string;
   roomCode: string;
+  token: string;
+  /** Last server seq received; the server resends what came after it. */
+  lastSeq?: number;
 }
 
-export type ClientMessage = EchoMessage | CreateRoomMessage | JoinRoomMessage | TurnOrderPickMessage | PlaceCardMessage | PassMessage | PeekMessage | SuggestSwapMessage | SkipSwapMessage | RespondSwapMessage | SendEmoteMessage | PlayAgainMessage | ExitGameMessage | ReconnectMessage;
+export type ClientMessage = EchoMessage | CreateRoomMessage | JoinRoomMessage | TurnOrderPickMessage | PlaceCardMessage | PassMessage | PeekMessage | SuggestSwapMessage | SkipSwapMessage | RespondSwapMessage | SendEmoteMessage | PlayAgainMessage | ExitGameMessage | ReconnectMessage | RequestStateMessage;
 
 // --- Server → Client messages ---
 
@@ -100,6 +107,7 @@ export interface RoomCreatedMessage extends BaseMessage {
   type: 'room_created';
   roomCode: string;
   playerNumber: number;
+  token: string;
 }
 
 export interface PlayerJoinedMessage extends BaseMessage {
@@ -107,6 +115,7 @@ export interface PlayerJoinedMessage extends BaseMessage {
   playerName: string;
   playerNumber: number;
   partnerName: string;
+  token: string;
 }
 
 export interface PlayerDisconnectedMessage extends BaseMessage {
@@ -136,11 +145,11 @@ export interface GameStartMessage extends BaseMessage {
   type: 'game_start';
   hand: Card[];
   firstPlayer: number;
-  handUsed?: boolean[];
 }
 
 export interface YourTurnMessage extends BaseMessage {
   type: 'your_turn';
+  deadline?: number;
 }
 
 export interface CardPlacedMessage extends BaseMessage {
@@ -163,6 +172,7 @@ export interface PeekResultMessage extends BaseMessage {
 export interface SwapPromptMessage extends BaseMessage {
   type: 'swap_prompt';
   byPlayer: number;
+  deadline?: number;
 }
 
 export interface SwapSuggestedMessage extends BaseMessage {
@@ -170,6 +180,7 @@ export interface SwapSuggestedMessage extends BaseMessage {
   slotA: number;
   slotB: number;
   byPlayer: number;
+  deadline?: number;
 }
 
 export interface SwapResultMessage extends BaseMessage {
@@ -193,6 +204,28 @@ export interface GameResultMessage extends BaseMessage {
   board: { slotIndex: number; card: Card }[];
 }
 
+/** GameStateMessage is the player's complete view, sent on reconnect and on request. */
+export interface GameStateMessage extends BaseMessage {
+  type: 'game_state';
+  playerName: string;
+  playerNumber: number;
+  players: string[];
+  phase: string;
+  firstPlayer?: number;
+  currentTurn?: number;
+  boardOwner?: number[];
+  passesUsed?: number[];
+  swapsAccepted?: number[];
+  swapHistory?: { slotA: number; slotB: number; byPlayer: number }[];
+  swapPending?: SwapSuggestedMessage;
+  playAgainReady?: boolean[];
+  deadline?: number;
+  result?: GameResultMessage;
+  hand?: Card[];
+  handUsed?: boolean[];
+  pick?: string;
+}
+
 export interface EmoteReceivedMessage extends BaseMessage {
   type: 'emote_received';
   emote: string;
@@ -228,6 +261,7 @@ export type ServerMessage =
   | SwapResultMessage
   | RevealCardMessage
   | GameResultMessage
+  | GameStateMessage
   | EmoteReceivedMessage
   | PlayAgainWaitingMessage
   | PartnerExitedMessage;
@@ -132,16 +132,16 @@ describe('WebSocketService', () => {
   });
 
   it('should save credentials to sessionStorage on setReconnectCredentials', () => {
-    service.setReconnectCredentials('Alice', 'ABCD');
+    service.setReconnectCredentials('Alice', 'ABCD', 'tok');
     const stored = sessionStorage.getItem('reconnect-credentials');
     expect(stored).toBeTruthy();
-    expect(JSON.parse(stored!)).toEqual({ playerName: 'Alice', roomCode: 'ABCD' });
+    expect(JSON.parse(stored!)).toEqual({ playerName: 'Alice', roomCode: 'ABCD', token: 'tok' });
   });
 
   it('should return stored credentials from getStoredCredentials', () => {
-    sessionStorage.setItem('reconnect-credentials', JSON.stringify({ playerName: 'Bob', roomCode: 'XY12' }));
+    sessionStorage.setItem('reconnect-credentials', JSON.stringify({ playerName: 'Bob', roomCode: 'XY12', token: 'tok' }));
     const result = service.getStoredCredentials();
-    expect(result).toEqual({ playerName: 'Bob', roomCode: 'XY12' });
+    expect(result).toEqual({ playerName: 'Bob', roomCode: 'XY12', token: 'tok' });
   });
 
   it('should return null from getStoredCredentials when nothing stored', () => {
@@ -149,14 +149,14 @@ describe('WebSocketService', () => {
   });
 
   it('should clear sessionStorage on clearReconnectCredentials', () => {
-    service.setReconnectCredentials('Alice', 'ABCD');
+    service.setReconnectCredentials('Alice', 'ABCD', 'tok');
     service.clearReconnectCredentials();
     expect(sessionStorage.getItem('reconnect-credentials')).toBeNull();
     expect(service.getStoredCredentials()).toBeNull();
   });
 
   it('should NOT clear sessionStorage on disconnect', () => {
-    service.setReconnectCredentials('Alice', 'ABCD');
+    service.setReconnectCredentials('Alice', 'ABCD', 'tok');
     service.connect('/ws');
     MockWebSocket.instances[0].simulateOpen();
     service.disconnect();
@@ -164,21 +164,21 @@ describe('WebSocketService', () => {
   });
 
   it('should load credentials from sessionStorage on open when not in memory', () => {
-    sessionStorage.setItem('reconnect-credentials', JSON.stringify({ playerName: 'Alice', roomCode: 'ABCD' }));
+    sessionStorage.setItem('reconnect-credentials', JSON.stringify({ playerName: 'Alice', roomCode: 'ABCD', token: 'tok' }));
     service.connect('/ws');
     MockWebSocket.instances[0].simulateOpen();
     const sent = MockWebSocket.instances[0].sent;
     expect(sent.length).toBe(1);
-    expect(JSON.parse(sent[0])).toEqual({ type: 'reconnect', name: 'Alice', roomCode: 'ABCD' });
+    expect(JSON.parse(sent[0])).toEqual({ type: 'reconnect', name: 'Alice', roomCode: 'ABCD', token: 'tok' });
   });
 
   it('should send reconnect with in-memory credentials when available', () => {
-    service.setReconnectCredentials('Alice', 'ABCD');
+    service.setReconnectCredentials('Alice', 'ABCD', 'tok');
     service.connect('/ws');
     MockWebSocket.instances[0].simulateOpen();
     const sent = MockWebSocket.instances[0].sent;
     expect(sent.length).toBe(1);
-    expect(JSON.parse(sent[0])).toEqual({ type: 'reconnect', name: 'Alice', roomCode: 'ABCD' });
+    expect(JSON.parse(sent[0])).toEqual({ type: 'reconnect', name: 'Alice', roomCode: 'ABCD', token: 'tok' });
   });
 
   it('should not reconnect from stale socket close after connect()', async () => {
@@ -26,6 +26,9 @@ export class WebSocketService implements OnDestroy {
   /** Stored credentials for reconnection after unexpected disconnect. */
   private reconnectName: string | null = null;
   private reconnectRoomCode: string | null = null;
+  private reconnectToken: string | null = null;
+  /** Sequence number of the last server message, so a reconnect can resume after it. */
+  private lastSeq = 0;
 
   connect(path: string): void {
     this.disconnect();
@@ -42,6 +45,8 @@ export class WebSocketService implements OnDestroy {
     this.pendingMessages = [];
     this.reconnectName = null;
     this.reconnectRoomCode = null;
+    this.reconnectToken = null;
+    this.lastSeq = 0;
     if (this.socket) {
       this.socket.close();
       this.socket = null;
@@ -49,22 +54,27 @@ export class WebSocketService implements OnDestroy {
     this.status.set('disconnected');
   }
 
-  /** Store credentials so the service can auto-reconnect to the same room. */
-  setReconnectCredentials(name: string, roomCode: string): void {
+  /**
+   * Store credentials so the service can auto-reconnect to the same room.
+   * The session token changes on every reconnect, so always store the latest.
+   */
+  setReconnectCredentials(name: string, roomCode: string, token: string): void {
     this.reconnectName = name;
     this.reconnectRoomCode = roomCode;
-    sessionStorage.setItem(RECONNECT_STORAGE_KEY, JSON.stringify({ playerName: name, roomCode }));
+    this.reconnectToken = token;
+    sessionStorage.setItem(RECONNECT_STORAGE_KEY, JSON.stringify({ playerName: name, roomCode, token }));
   }
 
   /** Clear reconnect credentials from both memory and sessionStorage. */
   clearReconnectCredentials(): void {
     this.reconnectName = null;
     this.reconnectRoomCode = null;
+    this.reconnectToken = null;
     sessionStorage.removeItem(RECONNECT_STORAGE_KEY);
   }
 
   /** Get stored credentials from sessionStorage (for page reload recovery). */
-  getStoredCredentials(): { playerName: string; roomCode: string } | null {
+  getStoredCredentials(): { playerName: string; roomCode: string; token: string } | null {
     const stored = sessionStorage.getItem(RECONNECT_STORAGE_KEY);
     if (!stored) return null;
 
@@ -108,12 +118,19 @@ export class WebSocketService implements OnDestroy {
         if (stored) {
           this.reconnectName = stored.playerName;
           this.reconnectRoomCode = stored.roomCode;
+          this.reconnectToken = stored.token;
         }
       }
 
       // If we have stored credentials, send a reconnect message
       if (this.reconnectName && this.reconnectRoomCode) {
-        this.send({ type: 'reconnect', name: this.reconnectName, roomCode: this.reconnectRoomCode });
+        this.send({
+          type: 'reconnect',
+          name: this.reconnectName,
+          roomCode: this.reconnectRoomCode,
+          token: this.reconnectToken ?? '',
+          ...(this.lastSeq > 0 ? { lastSeq: this.lastSeq } : {}),
+        });
       }
 
       this.flushPending();
@@ -122,7 +139,10 @@ export class WebSocketService implements OnDestroy {
     socket.onmessage = (event: MessageEvent) => {
       if (this.socket !== socket) return;
       try {
-        const message: ServerMessage = JSON.parse(event.data);
+        const message: ServerMessage & { seq?: number } = JSON.parse(event.data);
+        if (typeof message.seq === 'number') {
+          this.lastSeq = message.seq;
+        }
         this.messagesSubject.next(message);
       } catch {
         console.error('Failed to parse WebSocket message:', event.data);